@@ -0,0 +1,208 @@
+// Command benchreport runs the benchmarks of the data structures and prints ns/op and allocs/op of every benchmark as a table with one column per input size, so the complexity claimed in the doc comments can be checked at a glance and regressions stand out.
+//
+// Benchmarks opt into the size columns by ending their name with size=N, for example BenchmarkStack/Push/size=1000. Other benchmarks get a single column.
+//
+// Usage:
+//
+//	go run ./cmd/benchreport [-bench regexp] [-benchtime d] [packages]
+//	go test -run '^$' -bench . -benchmem ./... | go run ./cmd/benchreport -input -
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+func main() {
+	bench := flag.String("bench", ".", "regular expression selecting the benchmarks to run")
+	benchtime := flag.String("benchtime", "", "passed to go test -benchtime when set")
+	input := flag.String("input", "", "read go test -bench output from this file instead of running the benchmarks, - reads stdin")
+	flag.Parse()
+
+	packages := flag.Args()
+	if len(packages) == 0 {
+		packages = []string{"./datastructure/..."}
+	}
+
+	var tables []*table
+	var err error
+	switch *input {
+	case "":
+		tables, err = runBenchmarks(*bench, *benchtime, packages)
+	case "-":
+		tables, err = parse(os.Stdin)
+	default:
+		var file *os.File
+		file, err = os.Open(*input)
+		if err == nil {
+			tables, err = parse(file)
+			file.Close()
+		}
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "benchreport:", err)
+		os.Exit(1)
+	}
+	render(os.Stdout, tables)
+}
+
+// runBenchmarks runs go test on the packages without any tests, only the selected benchmarks with allocation reporting, and parses its output.
+func runBenchmarks(bench string, benchtime string, packages []string) ([]*table, error) {
+	args := []string{"test", "-run", "^$", "-bench", bench, "-benchmem"}
+	if benchtime != "" {
+		args = append(args, "-benchtime", benchtime)
+	}
+	cmd := exec.Command("go", append(args, packages...)...)
+	cmd.Stderr = os.Stderr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+	tables, parseErr := parse(stdout)
+	if err := cmd.Wait(); err != nil {
+		return nil, fmt.Errorf("go test: %w", err)
+	}
+	return tables, parseErr
+}
+
+// result is one measurement of a benchmark.
+type result struct {
+	nsPerOp     float64
+	allocsPerOp int64
+	hasAllocs   bool
+}
+
+// table holds the results of one package. Rows are the benchmark names without the size, in the order they ran.
+type table struct {
+	pkg   string
+	rows  []string
+	sizes []int
+	cells map[string]map[int]result
+}
+
+// noSize is the column of the benchmarks whose name does not end with size=N.
+const noSize = -1
+
+var (
+	benchmarkLine = regexp.MustCompile(`^(Benchmark\S+)\s+\d+\s+([\d.]+) ns/op(?:.*?\s(\d+) allocs/op)?`)
+	procsSuffix   = regexp.MustCompile(`-\d+$`)
+	sizeSuffix    = regexp.MustCompile(`/size=(\d+)$`)
+)
+
+// parse reads go test -bench output and groups the results by package, benchmark and size. Lines that are not results are ignored.
+func parse(r io.Reader) ([]*table, error) {
+	var tables []*table
+	var current *table
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if pkg, ok := strings.CutPrefix(line, "pkg: "); ok {
+			current = &table{pkg: pkg, cells: map[string]map[int]result{}}
+			tables = append(tables, current)
+			continue
+		}
+		match := benchmarkLine.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+		if current == nil {
+			current = &table{cells: map[string]map[int]result{}}
+			tables = append(tables, current)
+		}
+
+		name := procsSuffix.ReplaceAllString(match[1], "")
+		size := noSize
+		if sizeMatch := sizeSuffix.FindStringSubmatch(name); sizeMatch != nil {
+			size, _ = strconv.Atoi(sizeMatch[1])
+			name = strings.TrimSuffix(name, sizeMatch[0])
+		}
+		nsPerOp, err := strconv.ParseFloat(match[2], 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q: %w", line, err)
+		}
+		measurement := result{nsPerOp: nsPerOp}
+		if match[3] != "" {
+			measurement.allocsPerOp, _ = strconv.ParseInt(match[3], 10, 64)
+			measurement.hasAllocs = true
+		}
+		current.add(name, size, measurement)
+	}
+	return tables, scanner.Err()
+}
+
+func (t *table) add(name string, size int, measurement result) {
+	if _, ok := t.cells[name]; !ok {
+		t.rows = append(t.rows, name)
+		t.cells[name] = map[int]result{}
+	}
+	t.cells[name][size] = measurement
+	if !slices.Contains(t.sizes, size) {
+		t.sizes = append(t.sizes, size)
+		slices.Sort(t.sizes)
+	}
+}
+
+// render writes one aligned table per package. Every cell shows ns/op followed by allocs/op, cells of sizes a benchmark did not run at stay empty.
+func render(w io.Writer, tables []*table) {
+	for i, t := range tables {
+		if len(t.rows) == 0 {
+			continue
+		}
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		if t.pkg != "" {
+			fmt.Fprintln(w, t.pkg)
+		}
+		// Every name is padded to the same width, so the right aligned writer leaves the names left aligned and only the numbers right aligned.
+		width := len("benchmark")
+		for _, name := range t.rows {
+			width = max(width, len(strings.TrimPrefix(name, "Benchmark")))
+		}
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+		fmt.Fprintf(tw, "%-*s\t", width, "benchmark")
+		for _, size := range t.sizes {
+			if size == noSize {
+				fmt.Fprint(tw, "ns/op allocs/op\t")
+			} else {
+				fmt.Fprintf(tw, "n=%d\t", size)
+			}
+		}
+		fmt.Fprintln(tw)
+		for _, name := range t.rows {
+			fmt.Fprintf(tw, "%-*s\t", width, strings.TrimPrefix(name, "Benchmark"))
+			for _, size := range t.sizes {
+				measurement, ok := t.cells[name][size]
+				fmt.Fprintf(tw, "%s\t", formatCell(measurement, ok))
+			}
+			fmt.Fprintln(tw)
+		}
+		tw.Flush()
+	}
+}
+
+func formatCell(measurement result, ok bool) string {
+	if !ok {
+		return ""
+	}
+	ns := strconv.FormatFloat(measurement.nsPerOp, 'g', 4, 64)
+	if measurement.nsPerOp >= 10000 {
+		ns = strconv.FormatFloat(measurement.nsPerOp, 'f', 0, 64)
+	}
+	if !measurement.hasAllocs {
+		return ns + " ns"
+	}
+	return fmt.Sprintf("%s ns %d allocs", ns, measurement.allocsPerOp)
+}
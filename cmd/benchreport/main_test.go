@@ -0,0 +1,58 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const sampleOutput = `goos: linux
+goarch: amd64
+pkg: github.com/OmarFaruk-0x01/go_algorithms/datastructure/stack
+cpu: Intel(R) Xeon(R) Processor
+BenchmarkStack/Push/size=10-8         	 1000000	        4.305 ns/op	       0 B/op	       0 allocs/op
+BenchmarkStack/Push/size=1000-8       	 1000000	        5.615 ns/op	       8 B/op	       1 allocs/op
+BenchmarkStack/Clone/size=1000-8      	   10000	     12345.6 ns/op	    8192 B/op	       1 allocs/op
+BenchmarkConcurrentPushPop/LockFree-8 	 5000000	       61.20 ns/op
+PASS
+ok  	github.com/OmarFaruk-0x01/go_algorithms/datastructure/stack	1.234s
+pkg: github.com/OmarFaruk-0x01/go_algorithms/datastructure/queue
+BenchmarkQueue/RingQueue/Enqueue/size=10	 1000000	        12.84 ns/op	       0 B/op	       0 allocs/op
+`
+
+func TestParse(t *testing.T) {
+	tables, err := parse(strings.NewReader(sampleOutput))
+	assert.Nil(t, err)
+	assert.Len(t, tables, 2)
+
+	stack := tables[0]
+	assert.Equal(t, "github.com/OmarFaruk-0x01/go_algorithms/datastructure/stack", stack.pkg)
+	assert.Equal(t, []string{"BenchmarkStack/Push", "BenchmarkStack/Clone", "BenchmarkConcurrentPushPop/LockFree"}, stack.rows)
+	assert.Equal(t, []int{noSize, 10, 1000}, stack.sizes)
+	assert.Equal(t, result{nsPerOp: 5.615, allocsPerOp: 1, hasAllocs: true}, stack.cells["BenchmarkStack/Push"][1000])
+	assert.Equal(t, result{nsPerOp: 61.2}, stack.cells["BenchmarkConcurrentPushPop/LockFree"][noSize])
+
+	queue := tables[1]
+	assert.Equal(t, []string{"BenchmarkQueue/RingQueue/Enqueue"}, queue.rows)
+	assert.Equal(t, 12.84, queue.cells["BenchmarkQueue/RingQueue/Enqueue"][10].nsPerOp)
+}
+
+func TestRender(t *testing.T) {
+	tables, _ := parse(strings.NewReader(sampleOutput))
+	var out bytes.Buffer
+	render(&out, tables)
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	assert.Equal(t, "github.com/OmarFaruk-0x01/go_algorithms/datastructure/stack", lines[0])
+	assert.Contains(t, lines[1], "benchmark")
+	assert.Contains(t, lines[1], "n=10")
+	assert.Contains(t, lines[1], "n=1000")
+	assert.Contains(t, lines[2], "Stack/Push")
+	assert.Contains(t, lines[2], "4.305 ns 0 allocs")
+	assert.Contains(t, lines[2], "5.615 ns 1 allocs")
+	assert.Contains(t, lines[3], "12346 ns 1 allocs")
+	assert.Contains(t, lines[4], "61.2 ns")
+	assert.Contains(t, out.String(), "Queue/RingQueue/Enqueue")
+}
@@ -0,0 +1,183 @@
+package containertest
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+
+	"github.com/OmarFaruk-0x01/go_algorithms/datastructure/errs"
+	"github.com/OmarFaruk-0x01/go_algorithms/datastructure/linkedlist"
+	"github.com/stretchr/testify/assert"
+)
+
+// RunLinkedListSuite checks that the lists created by newList keep their items, size, first and last item in step with a slice while items are added and removed at both ends and in the middle, and that they report ErrEmpty and ErrIndexOutOfRange. Every subtest creates its own list.
+func RunLinkedListSuite(t *testing.T, newList func() linkedlist.LinkedList[int]) {
+	t.Run("Empty", func(t *testing.T) {
+		list := newList()
+		assertListItems(t, list, nil)
+		assert.ErrorIs(t, list.RemoveFirst(), errs.ErrEmpty)
+		assert.ErrorIs(t, list.RemoveLast(), errs.ErrEmpty)
+		assert.ErrorIs(t, list.RemoveAt(0), errs.ErrEmpty)
+	})
+
+	t.Run("AddAndInsert", func(t *testing.T) {
+		list := newList()
+		list.AddLast(2)
+		list.AddFirst(0)
+		list.AddLast(4)
+		assertListItems(t, list, []int{0, 2, 4})
+
+		list.InsertAt(1, 1)
+		list.InsertAt(3, 3)
+		assertListItems(t, list, []int{0, 1, 2, 3, 4})
+		list.InsertAt(-1, 0)
+		list.InsertAt(5, list.Size())
+		assertListItems(t, list, []int{-1, 0, 1, 2, 3, 4, 5})
+
+		assert.PanicsWithError(t, errs.IndexOutOfRange(8, 7).Error(), func() { list.InsertAt(6, 8) })
+		assert.PanicsWithError(t, errs.IndexOutOfRange(-1, 7).Error(), func() { list.InsertAt(6, -1) })
+		assertListItems(t, list, []int{-1, 0, 1, 2, 3, 4, 5})
+	})
+
+	t.Run("RemoveUntilEmpty", func(t *testing.T) {
+		list := newList()
+		for i := 0; i < 3; i++ {
+			list.AddLast(i)
+		}
+		assert.Nil(t, list.RemoveLast())
+		assert.Nil(t, list.RemoveFirst())
+		assertListItems(t, list, []int{1})
+		assert.Nil(t, list.RemoveLast())
+		assertListItems(t, list, nil)
+
+		// An emptied list must behave like a new one.
+		list.AddLast(7)
+		assertListItems(t, list, []int{7})
+		assert.Nil(t, list.RemoveFirst())
+		assertListItems(t, list, nil)
+		list.AddFirst(8)
+		list.AddLast(9)
+		assertListItems(t, list, []int{8, 9})
+		assert.Nil(t, list.RemoveAt(1))
+		assert.Nil(t, list.RemoveAt(0))
+		assertListItems(t, list, nil)
+	})
+
+	t.Run("RemoveAt", func(t *testing.T) {
+		list := newList()
+		for i := 0; i < 6; i++ {
+			list.AddLast(i)
+		}
+		assert.Nil(t, list.RemoveAt(2))
+		assert.Nil(t, list.RemoveAt(4))
+		assert.Nil(t, list.RemoveAt(0))
+		assertListItems(t, list, []int{1, 3, 4})
+
+		err := list.RemoveAt(3)
+		assert.ErrorIs(t, err, errs.ErrIndexOutOfRange)
+		var indexErr *errs.IndexOutOfRangeError
+		if assert.ErrorAs(t, err, &indexErr) {
+			assert.Equal(t, 3, indexErr.Index)
+			assert.Equal(t, 3, indexErr.Size)
+		}
+		assert.ErrorIs(t, list.RemoveAt(-1), errs.ErrIndexOutOfRange)
+		assertListItems(t, list, []int{1, 3, 4})
+	})
+
+	t.Run("AgainstSlice", func(t *testing.T) {
+		list := newList()
+		var model []int
+		for i := 0; i < 200; i++ {
+			switch i % 7 {
+			case 0, 3:
+				list.AddLast(i)
+				model = append(model, i)
+			case 1:
+				list.AddFirst(i)
+				model = slices.Insert(model, 0, i)
+			case 2, 5:
+				index := i % (len(model) + 1)
+				list.InsertAt(i, index)
+				model = slices.Insert(model, index, i)
+			case 4:
+				if len(model) > 0 {
+					index := i % len(model)
+					assert.Nil(t, list.RemoveAt(index))
+					model = slices.Delete(model, index, index+1)
+				}
+			case 6:
+				if i%2 == 0 {
+					assert.Nil(t, list.RemoveFirst())
+					model = model[1:]
+				} else {
+					assert.Nil(t, list.RemoveLast())
+					model = model[:len(model)-1]
+				}
+			}
+			assertListItems(t, list, model)
+		}
+	})
+
+	t.Run("Search", func(t *testing.T) {
+		list := newList()
+		for _, item := range []int{5, 8, 2, 8} {
+			list.AddLast(item)
+		}
+		assert.Equal(t, 1, list.IndexFunc(func(item int) bool { return item == 8 }))
+		assert.Equal(t, -1, list.IndexFunc(func(item int) bool { return item == 9 }))
+		assert.True(t, list.ContainsFunc(func(item int) bool { return item < 3 }))
+		assert.False(t, list.ContainsFunc(func(item int) bool { return item > 8 }))
+
+		other := newList()
+		for _, item := range []int{5, 8, 2, 8} {
+			other.AddLast(item)
+		}
+		equal := func(a, b int) bool { return a == b }
+		assert.True(t, list.EqualFunc(other, equal))
+		other.RemoveLast()
+		assert.False(t, list.EqualFunc(other, equal))
+	})
+
+	t.Run("Sort", func(t *testing.T) {
+		list := newList()
+		for _, item := range []int{4, 1, 3, 1, 2} {
+			list.AddLast(item)
+		}
+		linkedlist.SortFunc(list, cmp.Compare[int])
+		assertListItems(t, list, []int{1, 1, 2, 3, 4})
+		linkedlist.InsertSortedFunc(list, 0, cmp.Compare[int])
+		linkedlist.InsertSortedFunc(list, 5, cmp.Compare[int])
+		linkedlist.InsertSortedFunc(list, 3, cmp.Compare[int])
+		assertListItems(t, list, []int{0, 1, 1, 2, 3, 3, 4, 5})
+	})
+}
+
+// assertListItems checks every way the list exposes its items against the expected ones.
+func assertListItems(t *testing.T, list linkedlist.LinkedList[int], want []int) {
+	t.Helper()
+	if want == nil {
+		want = []int{}
+	}
+	assert.Equal(t, len(want), list.Size())
+	assert.Equal(t, want, append([]int{}, list.ToSlice()...))
+	assert.Equal(t, want, append([]int{}, slices.Collect(list.Values())...))
+
+	first, firstErr := list.First()
+	last, lastErr := list.Last()
+	if len(want) == 0 {
+		assert.ErrorIs(t, firstErr, errs.ErrEmpty)
+		assert.ErrorIs(t, lastErr, errs.ErrEmpty)
+		return
+	}
+	assert.Nil(t, firstErr)
+	assert.Nil(t, lastErr)
+	assert.Equal(t, want[0], first)
+	assert.Equal(t, want[len(want)-1], last)
+
+	traversed := []int{}
+	list.Traversal(func(item int, index int) {
+		assert.Equal(t, len(traversed), index)
+		traversed = append(traversed, item)
+	})
+	assert.Equal(t, want, traversed)
+}
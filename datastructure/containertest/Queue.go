@@ -0,0 +1,139 @@
+// Package containertest provides conformance suites for the container interfaces of this module. Any implementation, inside the module or not, can run them from its tests to check that it keeps the order, capacity, error and size guarantees the interfaces promise.
+//
+// Example:
+//
+//	func TestConformance(t *testing.T) {
+//		containertest.RunQueueSuite(t, func(max int) queue.Queue[int] {
+//			return mypackage.NewQueue[int](max)
+//		})
+//	}
+package containertest
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/OmarFaruk-0x01/go_algorithms/datastructure/errs"
+	"github.com/OmarFaruk-0x01/go_algorithms/datastructure/queue"
+	"github.com/stretchr/testify/assert"
+)
+
+// RunQueueSuite checks that the queues created by newQueue hand out their items in FIFO order, accept exactly max items, keep their items when the max shrinks and report ErrEmpty and ErrFull. Every subtest creates its own queues.
+func RunQueueSuite(t *testing.T, newQueue func(max int) queue.Queue[int]) {
+	t.Run("Empty", func(t *testing.T) {
+		q := newQueue(3)
+		assert.True(t, q.IsEmpty())
+		assert.False(t, q.IsFull())
+		assert.Equal(t, 0, q.Size())
+		_, err := q.Dequeue()
+		assert.ErrorIs(t, err, errs.ErrEmpty)
+		_, err = q.Peek()
+		assert.ErrorIs(t, err, errs.ErrEmpty)
+		assert.Empty(t, slices.Collect(q.Values()))
+	})
+
+	t.Run("ExactCapacity", func(t *testing.T) {
+		q := newQueue(3)
+		for i := 1; i <= 3; i++ {
+			assert.False(t, q.IsFull())
+			assert.Nil(t, q.Enqueue(i))
+			assert.Equal(t, i, q.Size())
+		}
+		assert.True(t, q.IsFull())
+		assert.ErrorIs(t, q.Enqueue(4), errs.ErrFull)
+		assert.Equal(t, 3, q.Size())
+
+		// Dequeueing frees exactly one slot, even when the items are spread over internal storage.
+		item, _ := q.Dequeue()
+		assert.Equal(t, 1, item)
+		assert.False(t, q.IsFull())
+		assert.Nil(t, q.Enqueue(4))
+		assert.ErrorIs(t, q.Enqueue(5), errs.ErrFull)
+		assert.Equal(t, []int{2, 3, 4}, slices.Collect(q.Values()))
+
+		empty := newQueue(0)
+		assert.True(t, empty.IsFull())
+		assert.ErrorIs(t, empty.Enqueue(1), errs.ErrFull)
+	})
+
+	t.Run("FIFO", func(t *testing.T) {
+		q := newQueue(4)
+		next, want := 0, 0
+		for round := 0; round < 10; round++ {
+			for !q.IsFull() {
+				assert.Nil(t, q.Enqueue(next))
+				next++
+			}
+			front, err := q.Peek()
+			assert.Nil(t, err)
+			assert.Equal(t, want, front)
+			for i := 0; i < 1+round%4; i++ {
+				item, err := q.Dequeue()
+				assert.Nil(t, err)
+				assert.Equal(t, want, item)
+				want++
+			}
+			assert.Equal(t, next-want, q.Size())
+			assert.Equal(t, q.Size() == 0, q.IsEmpty())
+		}
+	})
+
+	t.Run("PeekKeepsItem", func(t *testing.T) {
+		q := newQueue(2)
+		q.Enqueue(1)
+		for i := 0; i < 3; i++ {
+			front, err := q.Peek()
+			assert.Nil(t, err)
+			assert.Equal(t, 1, front)
+		}
+		assert.Equal(t, 1, q.Size())
+	})
+
+	t.Run("SetMax", func(t *testing.T) {
+		q := newQueue(2)
+		q.Enqueue(1)
+		q.Enqueue(2)
+		q.SetMax(3)
+		assert.False(t, q.IsFull())
+		assert.Nil(t, q.Enqueue(3))
+		assert.True(t, q.IsFull())
+
+		q.SetMax(1)
+		assert.True(t, q.IsFull())
+		assert.ErrorIs(t, q.Enqueue(4), errs.ErrFull)
+		assert.Equal(t, []int{1, 2, 3}, slices.Collect(q.Values()))
+		q.Dequeue()
+		q.Dequeue()
+		assert.True(t, q.IsFull())
+		q.Dequeue()
+		assert.False(t, q.IsFull())
+		assert.Nil(t, q.Enqueue(5))
+	})
+
+	t.Run("Iterators", func(t *testing.T) {
+		q := newQueue(5)
+		for i := 0; i < 5; i++ {
+			q.Enqueue(i)
+		}
+		q.Dequeue()
+		q.Enqueue(5)
+
+		indexes, items := []int{}, []int{}
+		for index, item := range q.All() {
+			indexes = append(indexes, index)
+			items = append(items, item)
+		}
+		assert.Equal(t, []int{0, 1, 2, 3, 4}, indexes)
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, items)
+
+		items = []int{}
+		for item := range q.Values() {
+			if item == 3 {
+				break
+			}
+			items = append(items, item)
+		}
+		assert.Equal(t, []int{1, 2}, items)
+		assert.Equal(t, 5, q.Size())
+	})
+}
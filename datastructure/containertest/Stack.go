@@ -0,0 +1,93 @@
+package containertest
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/OmarFaruk-0x01/go_algorithms/datastructure/errs"
+	"github.com/OmarFaruk-0x01/go_algorithms/datastructure/stack"
+	"github.com/stretchr/testify/assert"
+)
+
+// RunStackSuite checks that the stacks created by newStack hand out their items in LIFO order, iterate from the top to the bottom, keep their size in step with pushes and pops and report ErrEmpty. Every subtest creates its own stack.
+func RunStackSuite(t *testing.T, newStack func() stack.Stack[int]) {
+	t.Run("Empty", func(t *testing.T) {
+		s := newStack()
+		assert.True(t, s.IsEmpty())
+		assert.Equal(t, 0, s.Size())
+		_, err := s.Pop()
+		assert.ErrorIs(t, err, errs.ErrEmpty)
+		_, err = s.Peek()
+		assert.ErrorIs(t, err, errs.ErrEmpty)
+		assert.Empty(t, slices.Collect(s.Values()))
+	})
+
+	t.Run("LIFO", func(t *testing.T) {
+		s := newStack()
+		var model []int
+		next := 0
+		for round := 0; round < 10; round++ {
+			for i := 0; i < 3; i++ {
+				s.Push(next)
+				model = append(model, next)
+				next++
+			}
+			top, err := s.Peek()
+			assert.Nil(t, err)
+			assert.Equal(t, model[len(model)-1], top)
+			for i := 0; i < 1+round%3; i++ {
+				item, err := s.Pop()
+				assert.Nil(t, err)
+				assert.Equal(t, model[len(model)-1], item)
+				model = model[:len(model)-1]
+			}
+			assert.Equal(t, len(model), s.Size())
+			assert.Equal(t, len(model) == 0, s.IsEmpty())
+		}
+		for !s.IsEmpty() {
+			item, _ := s.Pop()
+			assert.Equal(t, model[len(model)-1], item)
+			model = model[:len(model)-1]
+		}
+		assert.Empty(t, model)
+		assert.Equal(t, 0, s.Size())
+		_, err := s.Pop()
+		assert.ErrorIs(t, err, errs.ErrEmpty)
+	})
+
+	t.Run("PeekKeepsItem", func(t *testing.T) {
+		s := newStack()
+		s.Push(1)
+		for i := 0; i < 3; i++ {
+			top, err := s.Peek()
+			assert.Nil(t, err)
+			assert.Equal(t, 1, top)
+		}
+		assert.Equal(t, 1, s.Size())
+	})
+
+	t.Run("Iterators", func(t *testing.T) {
+		s := newStack()
+		for i := 1; i <= 4; i++ {
+			s.Push(i)
+		}
+
+		indexes, items := []int{}, []int{}
+		for index, item := range s.All() {
+			indexes = append(indexes, index)
+			items = append(items, item)
+		}
+		assert.Equal(t, []int{0, 1, 2, 3}, indexes)
+		assert.Equal(t, []int{4, 3, 2, 1}, items)
+
+		items = []int{}
+		for item := range s.Values() {
+			if item == 2 {
+				break
+			}
+			items = append(items, item)
+		}
+		assert.Equal(t, []int{4, 3}, items)
+		assert.Equal(t, 4, s.Size())
+	})
+}
@@ -0,0 +1,262 @@
+package deque
+
+import (
+	"fmt"
+	"iter"
+	"math"
+
+	"github.com/OmarFaruk-0x01/go_algorithms/datastructure/errs"
+)
+
+var (
+	// ErrEmpty is returned when an item is read or removed from an empty deque.
+	ErrEmpty = errs.ErrEmpty
+	// ErrFull is returned when an item is added to a deque that reached its max.
+	ErrFull = errs.ErrFull
+	// ErrIndexOutOfRange is matched by the error At returns for an index outside of the deque.
+	ErrIndexOutOfRange = errs.ErrIndexOutOfRange
+)
+
+// minCapacity is the smallest buffer a deque allocates, so the first few pushes do not reallocate one by one.
+const minCapacity = 8
+
+type Deque[T any] interface {
+	PushFront(item T) error
+	PushBack(item T) error
+	PopFront() (T, error)
+	PopBack() (T, error)
+	Front() (T, error)
+	Back() (T, error)
+	At(index int) (T, error)
+	Size() int
+	IsEmpty() bool
+	IsFull() bool
+	SetMax(max int)
+	All() iter.Seq2[int, T]
+	Values() iter.Seq[T]
+	Backward() iter.Seq2[int, T]
+}
+
+// deque is a double-ended queue stored in a circular buffer that doubles when it is full and halves when it is mostly empty, so pushing and popping at either end is amortized O(1).
+//
+// Fields:
+//
+//	buffer: The circular buffer, its length is always a power of two.
+//	head: The index of the front item inside the buffer.
+//	size: The number of items stored in the deque.
+//	max: The maximum number of items the deque accepts, math.MaxInt for a deque without a max.
+type deque[T any] struct {
+	buffer []T
+	head   int
+	size   int
+	max    int
+	_nil   T
+}
+
+// New creates an empty deque without a max, which is the same as a max of math.MaxInt.
+func New[T any]() *deque[T] {
+	return &deque[T]{max: math.MaxInt}
+}
+
+// NewBounded creates an empty deque that accepts up to maxItem items. Like a Queue, a deque with a max of 0 or less is always full.
+func NewBounded[T any](maxItem int) *deque[T] {
+	return &deque[T]{max: maxItem}
+}
+
+// FromSlice creates an unbounded deque holding the items, the first item is at the front.
+func FromSlice[T any](items []T) *deque[T] {
+	d := New[T]()
+	for _, item := range items {
+		d.PushBack(item)
+	}
+	return d
+}
+
+// Of creates an unbounded deque holding the given items, the first item is at the front.
+func Of[T any](items ...T) *deque[T] {
+	return FromSlice(items)
+}
+
+// PushFront adds an item before the front item.
+//
+// Complexity:
+//
+//	Time - amortized O(1)
+func (d *deque[T]) PushFront(item T) error {
+	if d.IsFull() {
+		return ErrFull
+	}
+	d.grow()
+	d.head = d.index(-1)
+	d.buffer[d.head] = item
+	d.size++
+	return nil
+}
+
+// PushBack adds an item after the back item.
+//
+// Complexity:
+//
+//	Time - amortized O(1)
+func (d *deque[T]) PushBack(item T) error {
+	if d.IsFull() {
+		return ErrFull
+	}
+	d.grow()
+	d.buffer[d.index(d.size)] = item
+	d.size++
+	return nil
+}
+
+// PopFront removes and returns the front item.
+//
+// Complexity:
+//
+//	Time - amortized O(1)
+func (d *deque[T]) PopFront() (T, error) {
+	if d.IsEmpty() {
+		return d._nil, ErrEmpty
+	}
+	item := d.buffer[d.head]
+	d.buffer[d.head] = d._nil
+	d.head = d.index(1)
+	d.size--
+	d.shrink()
+	return item, nil
+}
+
+// PopBack removes and returns the back item.
+//
+// Complexity:
+//
+//	Time - amortized O(1)
+func (d *deque[T]) PopBack() (T, error) {
+	if d.IsEmpty() {
+		return d._nil, ErrEmpty
+	}
+	last := d.index(d.size - 1)
+	item := d.buffer[last]
+	d.buffer[last] = d._nil
+	d.size--
+	d.shrink()
+	return item, nil
+}
+
+func (d *deque[T]) Front() (T, error) {
+	if d.IsEmpty() {
+		return d._nil, ErrEmpty
+	}
+	return d.buffer[d.head], nil
+}
+
+func (d *deque[T]) Back() (T, error) {
+	if d.IsEmpty() {
+		return d._nil, ErrEmpty
+	}
+	return d.buffer[d.index(d.size-1)], nil
+}
+
+// At returns the item at the given position counted from the front.
+//
+// Complexity:
+//
+//	Time - O(1)
+func (d *deque[T]) At(index int) (T, error) {
+	if index < 0 || index >= d.size {
+		return d._nil, errs.IndexOutOfRange(index, d.size)
+	}
+	return d.buffer[d.index(index)], nil
+}
+
+func (d *deque[T]) Size() int {
+	return d.size
+}
+
+func (d *deque[T]) IsEmpty() bool {
+	return d.size == 0
+}
+
+func (d *deque[T]) IsFull() bool {
+	return d.size >= d.max
+}
+
+// SetMax changes the maximum number of items, 0 or less makes the deque full and math.MaxInt removes the bound. Items already in the deque are kept when it shrinks.
+func (d *deque[T]) SetMax(max int) {
+	d.max = max
+}
+
+// ToSlice creates a copy of the items of the deque from the front to the back.
+func (d *deque[T]) ToSlice() []T {
+	items := make([]T, 0, d.size)
+	for i := 0; i < d.size; i++ {
+		items = append(items, d.buffer[d.index(i)])
+	}
+	return items
+}
+
+// All returns an iterator over the items of the deque from the front to the back.
+func (d *deque[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := 0; i < d.size; i++ {
+			if !yield(i, d.buffer[d.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the items of the deque from the front to the back.
+func (d *deque[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < d.size; i++ {
+			if !yield(d.buffer[d.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the items of the deque from the back to the front, paired with their position counted from the front.
+func (d *deque[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := d.size - 1; i >= 0; i-- {
+			if !yield(i, d.buffer[d.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+func (d *deque[T]) String() string {
+	return fmt.Sprintf("%v", d.ToSlice())
+}
+
+// grow doubles the buffer when there is no free slot left.
+func (d *deque[T]) grow() {
+	if d.size < len(d.buffer) {
+		return
+	}
+	d.resize(max(2*len(d.buffer), minCapacity))
+}
+
+// shrink halves the buffer when at most a quarter of it is used, so a deque that once held many items does not keep the memory forever.
+func (d *deque[T]) shrink() {
+	if len(d.buffer) > minCapacity && d.size <= len(d.buffer)/4 {
+		d.resize(len(d.buffer) / 2)
+	}
+}
+
+// resize moves the items into a new buffer of the given capacity, the front item ends up at index 0.
+func (d *deque[T]) resize(capacity int) {
+	buffer := make([]T, capacity)
+	for i := 0; i < d.size; i++ {
+		buffer[i] = d.buffer[d.index(i)]
+	}
+	d.buffer = buffer
+	d.head = 0
+}
+
+// index converts the position of an item counted from the front of the deque to its index inside the buffer. The buffer length is a power of two, so masking wraps negative positions as well.
+func (d *deque[T]) index(position int) int {
+	return (d.head + position) & (len(d.buffer) - 1)
+}
@@ -0,0 +1,187 @@
+package deque
+
+import (
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var _ Deque[int] = (*deque[int])(nil)
+
+func TestPushPopBothEnds(t *testing.T) {
+	d := New[int]()
+	_, err := d.PopFront()
+	assert.ErrorIs(t, err, ErrEmpty)
+	_, err = d.PopBack()
+	assert.ErrorIs(t, err, ErrEmpty)
+	_, err = d.Front()
+	assert.ErrorIs(t, err, ErrEmpty)
+	_, err = d.Back()
+	assert.ErrorIs(t, err, ErrEmpty)
+
+	assert.Nil(t, d.PushBack(2))
+	assert.Nil(t, d.PushBack(3))
+	assert.Nil(t, d.PushFront(1))
+	assert.Nil(t, d.PushFront(0))
+	assert.Equal(t, []int{0, 1, 2, 3}, d.ToSlice())
+	assert.Equal(t, 4, d.Size())
+
+	front, _ := d.Front()
+	back, _ := d.Back()
+	assert.Equal(t, 0, front)
+	assert.Equal(t, 3, back)
+
+	item, _ := d.PopBack()
+	assert.Equal(t, 3, item)
+	item, _ = d.PopFront()
+	assert.Equal(t, 0, item)
+	assert.Equal(t, []int{1, 2}, d.ToSlice())
+}
+
+func TestAt(t *testing.T) {
+	d := New[string]()
+	for _, item := range []string{"c", "b", "a"} {
+		d.PushFront(item)
+	}
+	d.PushBack("d")
+	for i, want := range []string{"a", "b", "c", "d"} {
+		item, err := d.At(i)
+		assert.Nil(t, err)
+		assert.Equal(t, want, item)
+	}
+
+	_, err := d.At(4)
+	assert.ErrorIs(t, err, ErrIndexOutOfRange)
+	_, err = d.At(-1)
+	assert.ErrorIs(t, err, ErrIndexOutOfRange)
+}
+
+func TestBounded(t *testing.T) {
+	d := NewBounded[int](2)
+	assert.False(t, d.IsFull())
+	assert.Nil(t, d.PushBack(1))
+	assert.Nil(t, d.PushFront(0))
+	assert.True(t, d.IsFull())
+	assert.ErrorIs(t, d.PushBack(2), ErrFull)
+	assert.ErrorIs(t, d.PushFront(-1), ErrFull)
+
+	d.SetMax(1)
+	assert.Equal(t, []int{0, 1}, d.ToSlice())
+	d.PopFront()
+	assert.True(t, d.IsFull())
+
+	d.SetMax(0)
+	assert.True(t, d.IsFull())
+	assert.ErrorIs(t, d.PushBack(2), ErrFull)
+	d.PopFront()
+	assert.True(t, d.IsEmpty())
+	assert.True(t, d.IsFull())
+	assert.ErrorIs(t, d.PushFront(2), ErrFull)
+
+	d.SetMax(math.MaxInt)
+	for i := 0; i < 100; i++ {
+		assert.Nil(t, d.PushBack(i))
+	}
+	assert.False(t, d.IsFull())
+	assert.Equal(t, 100, d.Size())
+
+	full := NewBounded[int](0)
+	assert.True(t, full.IsFull())
+	assert.ErrorIs(t, full.PushBack(1), ErrFull)
+	assert.False(t, New[int]().IsFull())
+}
+
+func TestGrowAndShrinkAcrossWrap(t *testing.T) {
+	d := New[int]()
+	for i := 0; i < 5; i++ {
+		d.PushBack(i)
+	}
+	// Move the head around the end of the buffer before it has to grow.
+	for i := 1; i <= 5; i++ {
+		d.PushFront(-i)
+	}
+	assert.Equal(t, []int{-5, -4, -3, -2, -1, 0, 1, 2, 3, 4}, d.ToSlice())
+
+	for i := 5; i < 1000; i++ {
+		d.PushBack(i)
+	}
+	assert.Equal(t, 1005, d.Size())
+	assert.GreaterOrEqual(t, len(d.buffer), 1005)
+
+	for d.Size() > 3 {
+		d.PopFront()
+	}
+	assert.Equal(t, []int{997, 998, 999}, d.ToSlice())
+	assert.LessOrEqual(t, len(d.buffer), 16)
+}
+
+func TestPopZeroesSlots(t *testing.T) {
+	d := New[*int]()
+	one, two := 1, 2
+	d.PushBack(&one)
+	d.PushBack(&two)
+	d.PopFront()
+	d.PopBack()
+	for _, slot := range d.buffer {
+		assert.Nil(t, slot)
+	}
+}
+
+func TestAgainstSlice(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	d := New[int]()
+	var model []int
+	for i := 0; i < 10000; i++ {
+		switch r.Intn(4) {
+		case 0:
+			d.PushFront(i)
+			model = slices.Insert(model, 0, i)
+		case 1:
+			d.PushBack(i)
+			model = append(model, i)
+		case 2:
+			item, err := d.PopFront()
+			if len(model) == 0 {
+				assert.ErrorIs(t, err, ErrEmpty)
+				continue
+			}
+			assert.Equal(t, model[0], item)
+			model = model[1:]
+		case 3:
+			item, err := d.PopBack()
+			if len(model) == 0 {
+				assert.ErrorIs(t, err, ErrEmpty)
+				continue
+			}
+			assert.Equal(t, model[len(model)-1], item)
+			model = model[:len(model)-1]
+		}
+	}
+	assert.Equal(t, len(model), d.Size())
+	assert.Equal(t, model, slices.Collect(d.Values()))
+}
+
+func TestIterators(t *testing.T) {
+	d := Of(1, 2, 3)
+	var forward, backward []int
+	for index, item := range d.All() {
+		assert.Equal(t, index+1, item)
+		forward = append(forward, item)
+	}
+	for index, item := range d.Backward() {
+		assert.Equal(t, index+1, item)
+		backward = append(backward, item)
+	}
+	assert.Equal(t, []int{1, 2, 3}, forward)
+	assert.Equal(t, []int{3, 2, 1}, backward)
+	for item := range d.Values() {
+		if item == 2 {
+			break
+		}
+	}
+	assert.Equal(t, "[1 2 3]", d.String())
+	assert.Equal(t, []int{4, 5}, FromSlice([]int{4, 5}).ToSlice())
+}
@@ -0,0 +1,516 @@
+// Package diskqueue provides a Queue that keeps its items in files, so they survive a restart or a crash of the process.
+//
+// The items are appended as checksummed records to a log split into segment files. A checkpoint file remembers the position of the front item and is replaced atomically, so after a crash the queue resumes from the last checkpoint. Records cut short by a crash at the end of the log are detected and dropped when the queue is opened again. Segments whose items have all been dequeued are removed when a checkpoint moves past them.
+package diskqueue
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/OmarFaruk-0x01/go_algorithms/datastructure/errs"
+	"github.com/OmarFaruk-0x01/go_algorithms/datastructure/internal/durable"
+)
+
+var (
+	// ErrEmpty is returned when an item is read or removed from an empty queue.
+	ErrEmpty = errs.ErrEmpty
+	// ErrFull is returned when an item is added to a queue that reached its max.
+	ErrFull = errs.ErrFull
+	// ErrClosed is returned when a closed queue is used.
+	ErrClosed = errs.ErrClosed
+	// ErrTooLarge is returned by Enqueue for an item whose encoding is longer than 64 MiB.
+	ErrTooLarge = durable.ErrTooLarge
+	// ErrBroken is returned by Enqueue after a failed append could not be cut off the log again. The log may then end in a torn record followed by nothing the queue knows about, so the queue has to be opened again.
+	ErrBroken = errors.New("queue log is broken")
+	// ErrCorrupt is returned by Open when the checkpoint or a record before the end of the log is damaged, which a crash alone can not cause.
+	ErrCorrupt = durable.ErrCorrupt
+)
+
+const (
+	checkpointFile = "checkpoint"
+	segmentSuffix  = ".seg"
+)
+
+// position points to a record: the segment file and the byte offset inside it.
+type position struct {
+	segment uint64
+	offset  int64
+}
+
+// diskQueue is a Queue stored in a directory. It is not safe for concurrent use, wrap it with queue.NewSynchronizedQueue to share it between goroutines. Only one queue may use a directory at a time.
+//
+// Fields:
+//
+//	dir: The directory holding the segments and the checkpoint.
+//	options: The options with their defaults filled in.
+//	max: The maximum number of items the queue accepts, it is not stored on disk.
+//	size: The number of items between head and tail.
+//	head: The position of the front item.
+//	tail: The segment being appended to and its size.
+//	oldest: The oldest segment still on disk.
+//	writer: The open tail segment.
+//	reader: Reads the records at head, keeps the head segment open.
+//	unsyncedWrites: Enqueues since the log was last synced.
+//	unsyncedReads: Dequeues since the checkpoint was last written.
+//	broken: The error that left the log out of step with the queue, every Enqueue fails with it.
+//	closed: Whether Close has been called.
+type diskQueue[T any] struct {
+	dir            string
+	options        Options[T]
+	max            int
+	size           int
+	head           position
+	tail           position
+	oldest         uint64
+	writer         *os.File
+	reader         segmentReader
+	unsyncedWrites int
+	unsyncedReads  int
+	broken         error
+	closed         bool
+	_nil           T
+}
+
+// Open opens the queue stored in dir, creating the directory if needed, and recovers from an earlier crash: records torn at the end of the log are dropped and items dequeued after the last checkpoint are handed out again.
+//
+// Example:
+//
+//	jobs, err := diskqueue.Open[Job]("/var/lib/app/jobs", 10000, diskqueue.Options[Job]{Sync: diskqueue.SyncBatch})
+//	if err != nil {
+//		return err
+//	}
+//	defer jobs.Close()
+func Open[T any](dir string, maxItem int, options Options[T]) (*diskQueue[T], error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	q := &diskQueue[T]{dir: dir, options: options.withDefaults(), max: maxItem, reader: segmentReader{dir: dir}}
+	if err := q.recover(); err != nil {
+		q.reader.close()
+		return nil, err
+	}
+	return q, nil
+}
+
+// Enqueue appends an item to the log. When writing or syncing the record fails, the record is cut off the log again and the queue is left as it was, so the call can be retried. If even that fails the queue is broken: every later Enqueue returns ErrBroken and the queue has to be opened again.
+func (q *diskQueue[T]) Enqueue(item T) error {
+	if q.closed {
+		return ErrClosed
+	}
+	if q.broken != nil {
+		return q.broken
+	}
+	if q.IsFull() {
+		return ErrFull
+	}
+	payload, err := q.options.Codec.Marshal(item)
+	if err != nil {
+		return err
+	}
+	size := durable.RecordSize(payload)
+	if q.tail.offset > 0 && q.tail.offset+size > q.options.SegmentSize {
+		if err := q.rotate(); err != nil {
+			return err
+		}
+	}
+	syncDue := q.options.Sync == SyncAlways || (q.options.Sync == SyncBatch && q.unsyncedWrites+1 >= q.options.SyncEvery)
+	err = durable.AppendRecord(q.writer, payload)
+	if err == nil && syncDue {
+		err = q.writer.Sync()
+	}
+	if err != nil {
+		// Cut off whatever part of the record made it, so the next append does not follow a torn record and a retry does not enqueue the item twice.
+		if truncateErr := q.writer.Truncate(q.tail.offset); truncateErr != nil {
+			q.broken = fmt.Errorf("%w: %v", ErrBroken, errors.Join(err, truncateErr))
+			return q.broken
+		}
+		return err
+	}
+	q.tail.offset += size
+	q.size++
+	q.unsyncedWrites++
+	if syncDue {
+		q.unsyncedWrites = 0
+	}
+	return nil
+}
+
+// Dequeue removes and returns the front item. A record the codec can not decode is still removed, so it does not block the queue, and the decoding error is returned.
+//
+// A non-nil error does not always mean nothing was dequeued: when writing the checkpoint fails, the item is removed and returned together with the error. It is then handed out again if the queue is reopened before a later checkpoint succeeds.
+func (q *diskQueue[T]) Dequeue() (T, error) {
+	if q.closed {
+		return q._nil, ErrClosed
+	}
+	if q.IsEmpty() {
+		return q._nil, ErrEmpty
+	}
+	payload, next, err := q.reader.read(q.head, q.tail.segment)
+	if err != nil {
+		return q._nil, err
+	}
+	movedSegment := next.segment != q.head.segment
+	q.head = next
+	q.size--
+	q.unsyncedReads++
+
+	item, decodeErr := q.options.Codec.Unmarshal(payload)
+	due := q.options.Sync == SyncAlways || (q.options.Sync == SyncBatch && q.unsyncedReads >= q.options.SyncEvery)
+	if due || (movedSegment && q.options.Sync != SyncNever) {
+		if err := q.checkpoint(); err != nil {
+			return item, err
+		}
+	}
+	return item, decodeErr
+}
+
+func (q *diskQueue[T]) Peek() (T, error) {
+	if q.closed {
+		return q._nil, ErrClosed
+	}
+	if q.IsEmpty() {
+		return q._nil, ErrEmpty
+	}
+	payload, _, err := q.reader.read(q.head, q.tail.segment)
+	if err != nil {
+		return q._nil, err
+	}
+	return q.options.Codec.Unmarshal(payload)
+}
+
+func (q *diskQueue[T]) Size() int {
+	return q.size
+}
+
+func (q *diskQueue[T]) IsEmpty() bool {
+	return q.size == 0
+}
+
+func (q *diskQueue[T]) IsFull() bool {
+	return q.size >= q.max
+}
+
+// SetMax changes the maximum number of items. Items already in the queue are kept when it shrinks. The max only lives in memory, Open sets it again.
+func (q *diskQueue[T]) SetMax(max int) {
+	q.max = max
+}
+
+// All returns an iterator over the items of the queue from the front to the back. The items are read from the disk as the iteration goes, it stops early at the first record that can not be read or decoded.
+func (q *diskQueue[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		if q.closed {
+			return
+		}
+		reader := segmentReader{dir: q.dir}
+		defer reader.close()
+		at := q.head
+		for index := 0; index < q.size; index++ {
+			payload, next, err := reader.read(at, q.tail.segment)
+			if err != nil {
+				return
+			}
+			item, err := q.options.Codec.Unmarshal(payload)
+			if err != nil || !yield(index, item) {
+				return
+			}
+			at = next
+		}
+	}
+}
+
+// Values returns an iterator over the items of the queue from the front to the back with the same guarantees as All.
+func (q *diskQueue[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, item := range q.All() {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+func (q *diskQueue[T]) String() string {
+	return fmt.Sprintf("%v", slices.Collect(q.Values()))
+}
+
+// Sync forces every enqueued item to the disk and writes the checkpoint, whatever the sync policy is.
+func (q *diskQueue[T]) Sync() error {
+	if q.closed {
+		return ErrClosed
+	}
+	return q.checkpoint()
+}
+
+// Compact writes the checkpoint and removes the segments whose items have all been dequeued. It happens on its own whenever a checkpoint is written, Compact is for the SyncNever policy, which only writes checkpoints on Sync and Close.
+func (q *diskQueue[T]) Compact() error {
+	return q.Sync()
+}
+
+// Close syncs the queue and closes its files. The queue can not be used afterwards.
+func (q *diskQueue[T]) Close() error {
+	if q.closed {
+		return nil
+	}
+	err := q.checkpoint()
+	q.closed = true
+	q.reader.close()
+	if closeErr := q.writer.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// recover reads the checkpoint, removes the segments it moved past, counts the items of the remaining segments and cuts a torn record off the end of the last one.
+func (q *diskQueue[T]) recover() error {
+	segments, err := q.listSegments()
+	if err != nil {
+		return err
+	}
+	head, found, err := q.readCheckpoint()
+	if err != nil {
+		return err
+	}
+	if !found {
+		head = position{segment: 1}
+		if len(segments) > 0 {
+			head.segment = segments[0]
+		}
+	}
+
+	// A crash between writing a checkpoint and removing the segments it moved past leaves them behind.
+	kept := []uint64{}
+	for _, segment := range segments {
+		if segment < head.segment {
+			if err := os.Remove(q.segmentPath(segment)); err != nil {
+				return err
+			}
+			continue
+		}
+		kept = append(kept, segment)
+	}
+	if len(kept) == 0 {
+		kept = []uint64{head.segment}
+		head.offset = 0
+	}
+	for i, segment := range kept {
+		if segment != head.segment+uint64(i) {
+			return fmt.Errorf("%w: segment %d is missing", ErrCorrupt, head.segment+uint64(i))
+		}
+	}
+
+	for i, segment := range kept {
+		last := i == len(kept)-1
+		start := int64(0)
+		if i == 0 {
+			start = head.offset
+		}
+		count, end, err := q.scanSegment(segment, start)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			if !errors.Is(err, durable.ErrTorn) || !last {
+				return fmt.Errorf("%w: segment %d at offset %d: %v", ErrCorrupt, segment, end, err)
+			}
+			// Only the end of the log can be torn by a crash, drop the partial record.
+			if err := os.Truncate(q.segmentPath(segment), end); err != nil {
+				return err
+			}
+		}
+		if i == 0 {
+			head.offset = min(head.offset, end)
+		}
+		q.size += count
+		if last {
+			q.tail = position{segment: segment, offset: end}
+		}
+	}
+	q.head = head
+	q.oldest = head.segment
+	return q.openWriter()
+}
+
+// scanSegment counts the valid records of a segment from the given offset and returns where they end. A missing segment counts as empty. A damaged record that is the last thing in the segment is reported as durable.ErrTorn, damage followed by more data as durable.ErrCorrupt.
+func (q *diskQueue[T]) scanSegment(segment uint64, start int64) (int, int64, error) {
+	file, err := os.Open(q.segmentPath(segment))
+	if err != nil {
+		return 0, 0, err
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return 0, 0, err
+	}
+	// A checkpoint may point past the end of a log that lost unsynced writes in a crash.
+	start = min(start, info.Size())
+	reader := durable.NewReader(io.NewSectionReader(file, start, info.Size()-start))
+	count := 0
+	for {
+		if _, err := reader.Next(); err != nil {
+			if err == io.EOF {
+				err = nil
+			}
+			if errors.Is(err, durable.ErrCorrupt) && reader.AtTail() {
+				err = durable.ErrTorn
+			}
+			return count, start + reader.Offset(), err
+		}
+		count++
+	}
+}
+
+// rotate syncs and closes the tail segment and starts the next one.
+func (q *diskQueue[T]) rotate() error {
+	if err := q.syncLog(); err != nil {
+		return err
+	}
+	if err := q.writer.Close(); err != nil {
+		return err
+	}
+	q.tail = position{segment: q.tail.segment + 1}
+	return q.openWriter()
+}
+
+func (q *diskQueue[T]) openWriter() error {
+	writer, err := os.OpenFile(q.segmentPath(q.tail.segment), os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	q.writer = writer
+	if q.options.Sync != SyncNever {
+		return durable.SyncDir(q.dir)
+	}
+	return nil
+}
+
+func (q *diskQueue[T]) syncLog() error {
+	if q.unsyncedWrites == 0 {
+		return nil
+	}
+	if err := q.writer.Sync(); err != nil {
+		return err
+	}
+	q.unsyncedWrites = 0
+	return nil
+}
+
+// checkpoint stores the head position and removes the segments before it. The log is synced first, so the checkpoint never points to records that could still be lost.
+func (q *diskQueue[T]) checkpoint() error {
+	if err := q.syncLog(); err != nil {
+		return err
+	}
+	var payload [16]byte
+	binary.LittleEndian.PutUint64(payload[0:8], q.head.segment)
+	binary.LittleEndian.PutUint64(payload[8:16], uint64(q.head.offset))
+	var record bytes.Buffer
+	durable.AppendRecord(&record, payload[:])
+	if err := durable.WriteFile(filepath.Join(q.dir, checkpointFile), record.Bytes()); err != nil {
+		return err
+	}
+	q.unsyncedReads = 0
+
+	for ; q.oldest < q.head.segment; q.oldest++ {
+		if err := os.Remove(q.segmentPath(q.oldest)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+	return nil
+}
+
+// readCheckpoint returns the head position stored in the checkpoint and whether there is a checkpoint at all.
+func (q *diskQueue[T]) readCheckpoint() (position, bool, error) {
+	data, err := os.ReadFile(filepath.Join(q.dir, checkpointFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return position{}, false, nil
+	}
+	if err != nil {
+		return position{}, false, err
+	}
+	payload, err := durable.NewReader(bytes.NewReader(data)).Next()
+	if err != nil || len(payload) != 16 {
+		return position{}, false, fmt.Errorf("%w: checkpoint", ErrCorrupt)
+	}
+	head := position{
+		segment: binary.LittleEndian.Uint64(payload[0:8]),
+		offset:  int64(binary.LittleEndian.Uint64(payload[8:16])),
+	}
+	if head.segment == 0 || head.offset < 0 {
+		return position{}, false, fmt.Errorf("%w: checkpoint", ErrCorrupt)
+	}
+	return head, true, nil
+}
+
+// listSegments returns the ids of the segment files in the directory in ascending order.
+func (q *diskQueue[T]) listSegments() ([]uint64, error) {
+	entries, err := os.ReadDir(q.dir)
+	if err != nil {
+		return nil, err
+	}
+	segments := []uint64{}
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), segmentSuffix)
+		if !ok || entry.IsDir() {
+			continue
+		}
+		segment, err := strconv.ParseUint(name, 10, 64)
+		if err != nil || segment == 0 {
+			continue
+		}
+		segments = append(segments, segment)
+	}
+	slices.Sort(segments)
+	return segments, nil
+}
+
+func (q *diskQueue[T]) segmentPath(segment uint64) string {
+	return segmentPath(q.dir, segment)
+}
+
+func segmentPath(dir string, segment uint64) string {
+	return filepath.Join(dir, fmt.Sprintf("%020d%s", segment, segmentSuffix))
+}
+
+// segmentReader reads single records from the segments and keeps the segment it read last open, since reads mostly go through one segment in order.
+type segmentReader struct {
+	dir     string
+	segment uint64
+	file    *os.File
+}
+
+// read returns the record at the given position and the position after it. When the position is at the end of a segment before lastSegment, the record is read from the start of the next segment.
+func (r *segmentReader) read(at position, lastSegment uint64) ([]byte, position, error) {
+	for {
+		if r.file == nil || r.segment != at.segment {
+			r.close()
+			file, err := os.Open(segmentPath(r.dir, at.segment))
+			if err != nil {
+				return nil, at, err
+			}
+			r.file, r.segment = file, at.segment
+		}
+		reader := durable.NewReader(io.NewSectionReader(r.file, at.offset, math.MaxInt64-at.offset))
+		payload, err := reader.Next()
+		if err == io.EOF && at.segment < lastSegment {
+			at = position{segment: at.segment + 1}
+			continue
+		}
+		if err != nil {
+			return nil, at, err
+		}
+		return payload, position{segment: at.segment, offset: at.offset + reader.Offset()}, nil
+	}
+}
+
+func (r *segmentReader) close() {
+	if r.file != nil {
+		r.file.Close()
+		r.file = nil
+	}
+}
@@ -0,0 +1,363 @@
+package diskqueue
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/OmarFaruk-0x01/go_algorithms/datastructure/containertest"
+	"github.com/OmarFaruk-0x01/go_algorithms/datastructure/internal/durable"
+	"github.com/OmarFaruk-0x01/go_algorithms/datastructure/queue"
+	"github.com/stretchr/testify/assert"
+)
+
+var _ queue.Queue[int] = (*diskQueue[int])(nil)
+
+type job struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+func open(t *testing.T, dir string, options Options[int]) *diskQueue[int] {
+	t.Helper()
+	q, err := Open(dir, 1000, options)
+	assert.Nil(t, err)
+	if q == nil {
+		t.FailNow()
+	}
+	return q
+}
+
+func fill(t *testing.T, q *diskQueue[int], from int, to int) {
+	t.Helper()
+	for i := from; i < to; i++ {
+		assert.Nil(t, q.Enqueue(i))
+	}
+}
+
+func segmentFiles(t *testing.T, dir string) []string {
+	t.Helper()
+	matches, err := filepath.Glob(filepath.Join(dir, "*"+segmentSuffix))
+	assert.Nil(t, err)
+	slices.Sort(matches)
+	return matches
+}
+
+func TestConformance(t *testing.T) {
+	containertest.RunQueueSuite(t, func(max int) queue.Queue[int] {
+		q, err := Open(t.TempDir(), max, Options[int]{SegmentSize: 64})
+		assert.Nil(t, err)
+		t.Cleanup(func() { q.Close() })
+		return q
+	})
+}
+
+func TestReopen(t *testing.T) {
+	dir := t.TempDir()
+	q, err := Open(dir, 10, Options[job]{})
+	assert.Nil(t, err)
+	assert.Nil(t, q.Enqueue(job{1, "build"}))
+	assert.Nil(t, q.Enqueue(job{2, "test"}))
+	assert.Nil(t, q.Enqueue(job{3, "deploy"}))
+	front, err := q.Dequeue()
+	assert.Nil(t, err)
+	assert.Equal(t, job{1, "build"}, front)
+	assert.Nil(t, q.Close())
+	assert.ErrorIs(t, q.Enqueue(job{4, "late"}), ErrClosed)
+	_, err = q.Dequeue()
+	assert.ErrorIs(t, err, ErrClosed)
+
+	q, err = Open(dir, 10, Options[job]{})
+	assert.Nil(t, err)
+	defer q.Close()
+	assert.Equal(t, 2, q.Size())
+	assert.Equal(t, []job{{2, "test"}, {3, "deploy"}}, slices.Collect(q.Values()))
+	front, _ = q.Peek()
+	assert.Equal(t, job{2, "test"}, front)
+	assert.Equal(t, "[{2 test} {3 deploy}]", q.String())
+}
+
+func TestRotationAndCompaction(t *testing.T) {
+	dir := t.TempDir()
+	q := open(t, dir, Options[int]{SegmentSize: 40})
+	fill(t, q, 0, 30)
+	assert.Greater(t, len(segmentFiles(t, dir)), 5)
+
+	for i := 0; i < 28; i++ {
+		item, err := q.Dequeue()
+		assert.Nil(t, err)
+		assert.Equal(t, i, item)
+	}
+	// Only the segments still holding the last two items are left.
+	assert.LessOrEqual(t, len(segmentFiles(t, dir)), 2)
+	assert.Nil(t, q.Close())
+
+	q = open(t, dir, Options[int]{SegmentSize: 40})
+	defer q.Close()
+	assert.Equal(t, []int{28, 29}, slices.Collect(q.Values()))
+	fill(t, q, 30, 35)
+	for i := 28; i < 35; i++ {
+		item, _ := q.Dequeue()
+		assert.Equal(t, i, item)
+	}
+	assert.True(t, q.IsEmpty())
+}
+
+func TestCrashWithoutClose(t *testing.T) {
+	dir := t.TempDir()
+	q := open(t, dir, Options[int]{SegmentSize: 50})
+	fill(t, q, 0, 10)
+	q.Dequeue()
+	q.Dequeue()
+	// The process dies here: nothing is closed or synced beyond what the policy already did.
+
+	recovered := open(t, dir, Options[int]{SegmentSize: 50})
+	defer recovered.Close()
+	assert.Equal(t, 8, recovered.Size())
+	front, _ := recovered.Peek()
+	assert.Equal(t, 2, front)
+}
+
+func TestSyncNeverRedeliversAfterCrash(t *testing.T) {
+	dir := t.TempDir()
+	q := open(t, dir, Options[int]{Sync: SyncNever})
+	fill(t, q, 0, 5)
+	assert.Nil(t, q.Sync())
+	q.Dequeue()
+	q.Dequeue()
+
+	// The dequeues after the last checkpoint are handed out again, nothing is lost.
+	recovered := open(t, dir, Options[int]{Sync: SyncNever})
+	assert.Equal(t, []int{0, 1, 2, 3, 4}, slices.Collect(recovered.Values()))
+	recovered.Dequeue()
+	assert.Nil(t, recovered.Close())
+
+	recovered = open(t, dir, Options[int]{Sync: SyncNever})
+	defer recovered.Close()
+	assert.Equal(t, []int{1, 2, 3, 4}, slices.Collect(recovered.Values()))
+}
+
+func TestSyncBatch(t *testing.T) {
+	dir := t.TempDir()
+	q := open(t, dir, Options[int]{Sync: SyncBatch, SyncEvery: 3})
+	fill(t, q, 0, 6)
+	assert.Equal(t, 0, q.unsyncedWrites)
+	q.Dequeue()
+	q.Dequeue()
+	assert.Equal(t, 2, q.unsyncedReads)
+	q.Dequeue()
+	assert.Equal(t, 0, q.unsyncedReads)
+
+	recovered := open(t, dir, Options[int]{})
+	defer recovered.Close()
+	assert.Equal(t, []int{3, 4, 5}, slices.Collect(recovered.Values()))
+}
+
+// tornTail writes items 0 to 2, closes the queue and cuts the last segment at the given distance from its end.
+func tornTail(t *testing.T, cut int64) string {
+	dir := t.TempDir()
+	q := open(t, dir, Options[int]{})
+	fill(t, q, 0, 3)
+	assert.Nil(t, q.Close())
+	segments := segmentFiles(t, dir)
+	last := segments[len(segments)-1]
+	info, _ := os.Stat(last)
+	assert.Nil(t, os.Truncate(last, info.Size()-cut))
+	return dir
+}
+
+func TestRecoverTornPayload(t *testing.T) {
+	dir := tornTail(t, 1)
+	q := open(t, dir, Options[int]{})
+	assert.Equal(t, []int{0, 1}, slices.Collect(q.Values()))
+
+	// New items go right after the last complete record.
+	fill(t, q, 10, 12)
+	assert.Nil(t, q.Close())
+	q = open(t, dir, Options[int]{})
+	defer q.Close()
+	assert.Equal(t, []int{0, 1, 10, 11}, slices.Collect(q.Values()))
+}
+
+func TestRecoverTornHeader(t *testing.T) {
+	// The record of the single digit 2 is a header and one byte, cut in the middle of the header.
+	dir := tornTail(t, 5)
+	q := open(t, dir, Options[int]{})
+	defer q.Close()
+	assert.Equal(t, 2, q.Size())
+	fill(t, q, 3, 4)
+	assert.Equal(t, []int{0, 1, 3}, slices.Collect(q.Values()))
+}
+
+func TestRecoverCorruptTail(t *testing.T) {
+	dir := t.TempDir()
+	q := open(t, dir, Options[int]{})
+	fill(t, q, 0, 3)
+	q.Close()
+	segments := segmentFiles(t, dir)
+	data, _ := os.ReadFile(segments[0])
+	data[len(data)-1] ^= 0xff
+	os.WriteFile(segments[0], data, 0o644)
+
+	q = open(t, dir, Options[int]{})
+	defer q.Close()
+	assert.Equal(t, []int{0, 1}, slices.Collect(q.Values()))
+}
+
+func TestCorruptionBeforeTailFailsOpen(t *testing.T) {
+	dir := t.TempDir()
+	q := open(t, dir, Options[int]{SegmentSize: 30})
+	fill(t, q, 0, 10)
+	q.Close()
+	segments := segmentFiles(t, dir)
+	assert.Greater(t, len(segments), 2)
+	data, _ := os.ReadFile(segments[0])
+	data[len(data)-1] ^= 0xff
+	os.WriteFile(segments[0], data, 0o644)
+
+	_, err := Open(dir, 10, Options[int]{SegmentSize: 30})
+	assert.ErrorIs(t, err, ErrCorrupt)
+
+	os.Remove(segments[1])
+	_, err = Open(dir, 10, Options[int]{SegmentSize: 30})
+	assert.ErrorIs(t, err, ErrCorrupt)
+}
+
+func TestCorruptionInsideTailSegmentFailsOpen(t *testing.T) {
+	dir := t.TempDir()
+	q := open(t, dir, Options[int]{})
+	fill(t, q, 0, 10)
+	q.Close()
+	segments := segmentFiles(t, dir)
+	assert.Equal(t, 1, len(segments))
+	data, _ := os.ReadFile(segments[0])
+	data[durable.HeaderSize] ^= 0xff
+	os.WriteFile(segments[0], data, 0o644)
+
+	_, err := Open(dir, 10, Options[int]{})
+	assert.ErrorIs(t, err, ErrCorrupt)
+	// Nothing is cut off, the damaged segment is left for inspection.
+	after, _ := os.ReadFile(segments[0])
+	assert.Equal(t, data, after)
+}
+
+func TestFailedEnqueueBreaksQueue(t *testing.T) {
+	dir := t.TempDir()
+	q := open(t, dir, Options[int]{})
+	fill(t, q, 0, 2)
+	writable := q.writer
+
+	// A segment that can neither be written nor cut back stands in for a failing disk.
+	readOnly, err := os.Open(q.segmentPath(q.tail.segment))
+	assert.Nil(t, err)
+	q.writer = readOnly
+	assert.ErrorIs(t, q.Enqueue(2), ErrBroken)
+	assert.ErrorIs(t, q.Enqueue(3), ErrBroken)
+	assert.Equal(t, 2, q.Size())
+	front, err := q.Dequeue()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, front)
+	q.writer = writable
+	assert.Nil(t, q.Close())
+	readOnly.Close()
+
+	q = open(t, dir, Options[int]{})
+	defer q.Close()
+	assert.Equal(t, []int{1}, slices.Collect(q.Values()))
+}
+
+func TestCheckpointFile(t *testing.T) {
+	dir := t.TempDir()
+	q := open(t, dir, Options[int]{})
+	fill(t, q, 0, 3)
+	q.Dequeue()
+	q.Close()
+
+	// A temporary file left by a crash during a checkpoint is ignored.
+	os.WriteFile(filepath.Join(dir, checkpointFile+".tmp"), []byte("garbage"), 0o644)
+	q = open(t, dir, Options[int]{})
+	assert.Equal(t, []int{1, 2}, slices.Collect(q.Values()))
+	q.Close()
+
+	os.WriteFile(filepath.Join(dir, checkpointFile), []byte("garbage"), 0o644)
+	_, err := Open(dir, 10, Options[int]{})
+	assert.ErrorIs(t, err, ErrCorrupt)
+}
+
+func TestLeftoverSegmentsAreRemoved(t *testing.T) {
+	dir := t.TempDir()
+	q := open(t, dir, Options[int]{SegmentSize: 30})
+	fill(t, q, 0, 10)
+	for i := 0; i < 8; i++ {
+		q.Dequeue()
+	}
+	q.Close()
+	remaining := segmentFiles(t, dir)
+
+	// Put back a consumed segment, as if the crash happened right after the checkpoint was written.
+	os.WriteFile(segmentPath(dir, 1), []byte("stale"), 0o644)
+	q = open(t, dir, Options[int]{SegmentSize: 30})
+	defer q.Close()
+	assert.Equal(t, remaining, segmentFiles(t, dir))
+	assert.Equal(t, []int{8, 9}, slices.Collect(q.Values()))
+}
+
+func TestMaxAndCodec(t *testing.T) {
+	dir := t.TempDir()
+	q, err := Open(dir, 2, Options[map[job]bool]{Codec: GobCodec[map[job]bool]{}})
+	assert.Nil(t, err)
+	defer q.Close()
+	assert.Nil(t, q.Enqueue(map[job]bool{{1, "a"}: true}))
+	assert.Nil(t, q.Enqueue(map[job]bool{{2, "b"}: false}))
+	assert.ErrorIs(t, q.Enqueue(nil), ErrFull)
+	q.SetMax(3)
+	assert.False(t, q.IsFull())
+
+	item, err := q.Dequeue()
+	assert.Nil(t, err)
+	assert.Equal(t, map[job]bool{{1, "a"}: true}, item)
+}
+
+// padCodec encodes an int as that many zero bytes.
+type padCodec struct{}
+
+func (padCodec) Marshal(item int) ([]byte, error) {
+	return make([]byte, item), nil
+}
+
+func (padCodec) Unmarshal(data []byte) (int, error) {
+	return len(data), nil
+}
+
+func TestItemTooLarge(t *testing.T) {
+	dir := t.TempDir()
+	q, err := Open(dir, 10, Options[int]{Codec: padCodec{}})
+	assert.Nil(t, err)
+	defer q.Close()
+	assert.Nil(t, q.Enqueue(3))
+	err = q.Enqueue(durable.MaxPayloadSize + 1)
+	assert.ErrorIs(t, err, ErrTooLarge)
+	assert.NotErrorIs(t, err, ErrCorrupt)
+	assert.Nil(t, q.Enqueue(5))
+	assert.Equal(t, []int{3, 5}, slices.Collect(q.Values()))
+}
+
+func TestUndecodableRecordIsSkipped(t *testing.T) {
+	dir := t.TempDir()
+	strings, err := Open(dir, 10, Options[string]{})
+	assert.Nil(t, err)
+	strings.Enqueue("not a number")
+	strings.Close()
+
+	q := open(t, dir, Options[int]{})
+	defer q.Close()
+	q.Enqueue(1)
+	_, err = q.Peek()
+	assert.NotNil(t, err)
+	_, err = q.Dequeue()
+	assert.NotNil(t, err)
+	item, err := q.Dequeue()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, item)
+}
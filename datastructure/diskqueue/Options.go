@@ -0,0 +1,64 @@
+package diskqueue
+
+import "github.com/OmarFaruk-0x01/go_algorithms/datastructure/internal/durable"
+
+// SyncPolicy decides how often the queue forces its writes to the disk. Items enqueued and dequeued since the last sync may be lost in a crash: lost enqueues disappear, lost dequeues are handed out again after the restart.
+type SyncPolicy int
+
+const (
+	// SyncAlways syncs the log after every Enqueue and writes the checkpoint after every Dequeue. Nothing acknowledged is lost, at the price of one fsync per operation.
+	SyncAlways SyncPolicy = iota
+	// SyncBatch syncs the log and writes the checkpoint every Options.SyncEvery operations.
+	SyncBatch
+	// SyncNever leaves flushing to the operating system and only syncs on Sync and Close.
+	SyncNever
+)
+
+const (
+	defaultSegmentSize = 16 << 20
+	defaultSyncEvery   = 100
+)
+
+// Codec converts the items to and from the bytes stored in the log. Every durable.Codec is one, so the codecs of this package also fit a durablelist.
+type Codec[T any] interface {
+	durable.Codec[T]
+}
+
+// JSONCodec stores every item as JSON. It is the default codec.
+type JSONCodec[T any] struct {
+	durable.JSONCodec[T]
+}
+
+// GobCodec stores every item as a self-contained gob value. It handles the types JSON can not, like maps with struct keys, but repeats the type description in every record.
+type GobCodec[T any] struct {
+	durable.GobCodec[T]
+}
+
+// Options tunes a disk queue. The zero value is ready to use.
+//
+// Fields:
+//
+//	SegmentSize: The size in bytes after which the log moves on to a new segment file, 16 MiB when 0.
+//	Sync: How often writes are forced to the disk, SyncAlways when not set.
+//	SyncEvery: The number of operations between syncs with SyncBatch, 100 when 0.
+//	Codec: How items are stored, JSONCodec when nil.
+type Options[T any] struct {
+	SegmentSize int64
+	Sync        SyncPolicy
+	SyncEvery   int
+	Codec       Codec[T]
+}
+
+// withDefaults fills in the fields left at their zero value.
+func (o Options[T]) withDefaults() Options[T] {
+	if o.SegmentSize <= 0 {
+		o.SegmentSize = defaultSegmentSize
+	}
+	if o.SyncEvery <= 0 {
+		o.SyncEvery = defaultSyncEvery
+	}
+	if o.Codec == nil {
+		o.Codec = JSONCodec[T]{}
+	}
+	return o
+}
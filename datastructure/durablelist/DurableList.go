@@ -0,0 +1,528 @@
+// Package durablelist keeps a linked list on disk by writing every mutation to a write-ahead log before applying it, and periodically saving a snapshot of the whole list so the log stays short. After a crash, Open rebuilds the list exactly by loading the latest snapshot and replaying the log written after it.
+package durablelist
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/OmarFaruk-0x01/go_algorithms/datastructure/errs"
+	"github.com/OmarFaruk-0x01/go_algorithms/datastructure/internal/durable"
+	"github.com/OmarFaruk-0x01/go_algorithms/datastructure/linkedlist"
+)
+
+var (
+	// ErrEmpty is returned when an item is read or removed from an empty list.
+	ErrEmpty = errs.ErrEmpty
+	// ErrIndexOutOfRange is matched by the error InsertAt and RemoveAt return for an index outside of the list.
+	ErrIndexOutOfRange = errs.ErrIndexOutOfRange
+	// ErrClosed is returned when a closed list is changed.
+	ErrClosed = errs.ErrClosed
+	// ErrTooLarge is returned when an item is added whose encoding is longer than 64 MiB.
+	ErrTooLarge = durable.ErrTooLarge
+	// ErrBroken is returned by every change after a failed write could not be cut off the log again. The log may then hold a mutation the list in memory does not, so the list has to be opened again.
+	ErrBroken = errors.New("list log is broken")
+	// ErrCorrupt is returned by Open when the snapshot or a log record before the end of the log is damaged, which a crash alone can not cause.
+	ErrCorrupt = durable.ErrCorrupt
+)
+
+const (
+	snapshotFile = "snapshot"
+	logSuffix    = ".wal"
+)
+
+// operation is the kind of a log record, stored in its first byte.
+type operation byte
+
+const (
+	opAddFirst operation = iota + 1
+	opAddLast
+	opInsertAt
+	opRemoveAt
+	opRemoveFirst
+	opRemoveLast
+)
+
+// durableList is a linked list whose mutations are logged before they are applied. It is not safe for concurrent use and only one list may use a directory at a time.
+//
+// Fields:
+//
+//	dir: The directory holding the snapshot and the log.
+//	options: The options with their defaults filled in.
+//	list: The list in memory, every read is served from it.
+//	generation: The generation of the current log, the snapshot holds the list as it was when this log started.
+//	log: The open log of the current generation.
+//	logged: The number of mutations in the current log.
+//	broken: The error that left the log out of step with the list, every change fails with it.
+//	snapshotErr: The error of the last automatic snapshot, nil once a snapshot succeeds.
+//	closed: Whether Close has been called.
+type durableList[T any] struct {
+	dir         string
+	options     Options[T]
+	list        linkedlist.LinkedList[T]
+	generation  uint64
+	log         *os.File
+	logged      int
+	broken      error
+	snapshotErr error
+	closed      bool
+}
+
+// Open loads the list stored in dir, creating the directory if needed. The latest snapshot is loaded and the mutations logged after it are replayed. A record torn at the end of the log by a crash is dropped, together with the mutation it held.
+//
+// Example:
+//
+//	tasks, err := durablelist.Open[string]("/var/lib/app/tasks", durablelist.Options[string]{})
+//	if err != nil {
+//		return err
+//	}
+//	defer tasks.Close()
+//	err = tasks.AddLast("write report")
+func Open[T any](dir string, options Options[T]) (*durableList[T], error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	list := linkedlist.New[T]()
+	l := &durableList[T]{dir: dir, options: options.withDefaults(), list: &list}
+	if err := l.recover(); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// AddFirst logs and then adds an item to the beginning of the list.
+func (l *durableList[T]) AddFirst(item T) error {
+	if err := l.write(opAddFirst, 0, item); err != nil {
+		return err
+	}
+	l.list.AddFirst(item)
+	l.afterWrite()
+	return nil
+}
+
+// AddLast logs and then adds an item to the end of the list.
+func (l *durableList[T]) AddLast(item T) error {
+	if err := l.write(opAddLast, 0, item); err != nil {
+		return err
+	}
+	l.list.AddLast(item)
+	l.afterWrite()
+	return nil
+}
+
+// InsertAt logs and then adds an item at the given index. An index equal to the size appends the item. Where linkedlist.InsertAt panics for a negative index or one past the size, InsertAt returns an *errs.IndexOutOfRangeError and logs nothing.
+func (l *durableList[T]) InsertAt(item T, index int) error {
+	if err := l.checkOpen(); err != nil {
+		return err
+	}
+	if index < 0 || index > l.list.Size() {
+		return errs.IndexOutOfRange(index, l.list.Size())
+	}
+	if err := l.write(opInsertAt, index, item); err != nil {
+		return err
+	}
+	l.list.InsertAt(item, index)
+	l.afterWrite()
+	return nil
+}
+
+// RemoveAt logs and then removes the item at the given index. A removal that would fail is not logged.
+func (l *durableList[T]) RemoveAt(index int) error {
+	if err := l.checkRemove(index); err != nil {
+		return err
+	}
+	var _nil T
+	if err := l.write(opRemoveAt, index, _nil); err != nil {
+		return err
+	}
+	l.list.RemoveAt(index)
+	l.afterWrite()
+	return nil
+}
+
+// RemoveFirst logs and then removes the first item.
+func (l *durableList[T]) RemoveFirst() error {
+	if err := l.checkRemove(0); err != nil {
+		return err
+	}
+	var _nil T
+	if err := l.write(opRemoveFirst, 0, _nil); err != nil {
+		return err
+	}
+	l.list.RemoveFirst()
+	l.afterWrite()
+	return nil
+}
+
+// RemoveLast logs and then removes the last item.
+func (l *durableList[T]) RemoveLast() error {
+	if err := l.checkRemove(l.list.Size() - 1); err != nil {
+		return err
+	}
+	var _nil T
+	if err := l.write(opRemoveLast, 0, _nil); err != nil {
+		return err
+	}
+	l.list.RemoveLast()
+	l.afterWrite()
+	return nil
+}
+
+func (l *durableList[T]) Size() int {
+	return l.list.Size()
+}
+
+func (l *durableList[T]) First() (T, error) {
+	return l.list.First()
+}
+
+func (l *durableList[T]) Last() (T, error) {
+	return l.list.Last()
+}
+
+func (l *durableList[T]) ToSlice() []T {
+	return l.list.ToSlice()
+}
+
+func (l *durableList[T]) IndexFunc(match func(item T) bool) int {
+	return l.list.IndexFunc(match)
+}
+
+func (l *durableList[T]) ContainsFunc(match func(item T) bool) bool {
+	return l.list.ContainsFunc(match)
+}
+
+// All returns an iterator over the items of the list with their index, from the first to the last.
+func (l *durableList[T]) All() iter.Seq2[int, T] {
+	return l.list.All()
+}
+
+// Values returns an iterator over the items of the list from the first to the last.
+func (l *durableList[T]) Values() iter.Seq[T] {
+	return l.list.Values()
+}
+
+func (l *durableList[T]) String() string {
+	return fmt.Sprintf("%v", l.list.ToSlice())
+}
+
+// Sync forces the logged mutations to the disk. It is only needed with Options.NoSync.
+func (l *durableList[T]) Sync() error {
+	if err := l.checkOpen(); err != nil {
+		return err
+	}
+	return l.log.Sync()
+}
+
+// Snapshot saves the whole list and starts a new, empty log, removing the old one. It happens on its own every Options.SnapshotEvery mutations.
+//
+// The snapshot is written atomically and carries the generation of the log that starts after it, so a crash at any point leaves either the old snapshot with the old log or the new snapshot with the new log.
+func (l *durableList[T]) Snapshot() error {
+	if err := l.checkOpen(); err != nil {
+		return err
+	}
+	if err := l.log.Sync(); err != nil {
+		return err
+	}
+	next := l.generation + 1
+	if err := l.writeSnapshot(next); err != nil {
+		return err
+	}
+	if err := l.log.Close(); err != nil {
+		return err
+	}
+	previous := l.generation
+	l.generation = next
+	l.logged = 0
+	if err := l.openLog(); err != nil {
+		return err
+	}
+	l.snapshotErr = nil
+	return os.Remove(l.logPath(previous))
+}
+
+// SnapshotErr returns why the last automatic snapshot failed, or nil if it succeeded. The mutation that triggered it was still logged and applied. Until a snapshot succeeds the log keeps growing and every mutation tries again.
+func (l *durableList[T]) SnapshotErr() error {
+	return l.snapshotErr
+}
+
+// Close syncs the log and closes it. The list can not be changed afterwards, reading it still works.
+func (l *durableList[T]) Close() error {
+	if l.closed {
+		return nil
+	}
+	l.closed = true
+	err := l.log.Sync()
+	if closeErr := l.log.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+func (l *durableList[T]) checkOpen() error {
+	if l.closed {
+		return ErrClosed
+	}
+	return l.broken
+}
+
+// checkRemove returns the error the list would return for removing the item at the given index, so failing removals are never logged.
+func (l *durableList[T]) checkRemove(index int) error {
+	if err := l.checkOpen(); err != nil {
+		return err
+	}
+	if l.list.Size() == 0 {
+		return ErrEmpty
+	}
+	if index < 0 || index >= l.list.Size() {
+		return errs.IndexOutOfRange(index, l.list.Size())
+	}
+	return nil
+}
+
+// write appends one mutation to the log and syncs it unless NoSync is set. The list is only changed once the mutation is logged, a failed write is cut off the log again.
+func (l *durableList[T]) write(op operation, index int, item T) error {
+	if err := l.checkOpen(); err != nil {
+		return err
+	}
+	payload := []byte{byte(op)}
+	if op == opInsertAt || op == opRemoveAt {
+		payload = binary.AppendUvarint(payload, uint64(index))
+	}
+	if op == opAddFirst || op == opAddLast || op == opInsertAt {
+		data, err := l.options.Codec.Marshal(item)
+		if err != nil {
+			return err
+		}
+		payload = append(payload, data...)
+	}
+	info, err := l.log.Stat()
+	if err != nil {
+		return err
+	}
+	err = durable.AppendRecord(l.log, payload)
+	if err == nil && !l.options.NoSync {
+		err = l.log.Sync()
+	}
+	if err != nil {
+		// The list is not changed, so whatever part of the record made it has to go too, or replay would apply a mutation that never happened.
+		if truncateErr := l.log.Truncate(info.Size()); truncateErr != nil {
+			l.broken = fmt.Errorf("%w: %v", ErrBroken, errors.Join(err, truncateErr))
+			return l.broken
+		}
+		return err
+	}
+	return nil
+}
+
+// afterWrite takes a snapshot once enough mutations have been logged. The mutation is logged and applied by then, so a failed snapshot is not returned as its error, which would make callers retry and apply it twice. The failure is kept for SnapshotErr instead and the snapshot is tried again after the next mutation.
+func (l *durableList[T]) afterWrite() {
+	l.logged++
+	if l.options.SnapshotEvery > 0 && l.logged >= l.options.SnapshotEvery {
+		l.snapshotErr = l.Snapshot()
+	}
+}
+
+// apply replays one logged mutation on the list in memory.
+func (l *durableList[T]) apply(payload []byte) error {
+	if len(payload) == 0 {
+		return ErrCorrupt
+	}
+	op, rest := operation(payload[0]), payload[1:]
+	index := 0
+	if op == opInsertAt || op == opRemoveAt {
+		value, n := binary.Uvarint(rest)
+		if n <= 0 {
+			return ErrCorrupt
+		}
+		index, rest = int(value), rest[n:]
+	}
+	var item T
+	if op == opAddFirst || op == opAddLast || op == opInsertAt {
+		var err error
+		if item, err = l.options.Codec.Unmarshal(rest); err != nil {
+			return err
+		}
+	}
+	switch op {
+	case opAddFirst:
+		l.list.AddFirst(item)
+	case opAddLast:
+		l.list.AddLast(item)
+	case opInsertAt:
+		if index > l.list.Size() {
+			return ErrCorrupt
+		}
+		l.list.InsertAt(item, index)
+	case opRemoveAt:
+		return l.list.RemoveAt(index)
+	case opRemoveFirst:
+		return l.list.RemoveFirst()
+	case opRemoveLast:
+		return l.list.RemoveLast()
+	default:
+		return ErrCorrupt
+	}
+	return nil
+}
+
+// recover loads the snapshot, removes the logs it already covers and replays the log of its generation.
+func (l *durableList[T]) recover() error {
+	generation, err := l.readSnapshot()
+	if err != nil {
+		return err
+	}
+	l.generation = generation
+
+	logs, err := l.listLogs()
+	if err != nil {
+		return err
+	}
+	for _, log := range logs {
+		switch {
+		case log < generation:
+			// A crash between writing a snapshot and removing the log it covers leaves the log behind.
+			if err := os.Remove(l.logPath(log)); err != nil {
+				return err
+			}
+		case log > generation:
+			return fmt.Errorf("%w: log %d is newer than the snapshot", ErrCorrupt, log)
+		}
+	}
+
+	if err := l.replay(); err != nil {
+		return err
+	}
+	return l.openLog()
+}
+
+// replay applies the mutations of the current log and cuts a torn record off its end. A damaged record followed by more records fails with ErrCorrupt, the log is left as it is.
+func (l *durableList[T]) replay() error {
+	file, err := os.Open(l.logPath(l.generation))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	reader := durable.NewReader(file)
+	for {
+		payload, err := reader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if (errors.Is(err, durable.ErrTorn) || errors.Is(err, durable.ErrCorrupt)) && reader.AtTail() {
+			// Only the end of the log can be torn by a crash, and nothing was applied for the torn mutation.
+			return os.Truncate(l.logPath(l.generation), reader.Offset())
+		}
+		if errors.Is(err, durable.ErrCorrupt) {
+			return fmt.Errorf("%w: log %d at offset %d", ErrCorrupt, l.generation, reader.Offset())
+		}
+		if err != nil {
+			return err
+		}
+		if err := l.apply(payload); err != nil {
+			return fmt.Errorf("%w: log %d at offset %d: %v", ErrCorrupt, l.generation, reader.Offset(), err)
+		}
+		l.logged++
+	}
+}
+
+func (l *durableList[T]) openLog() error {
+	log, err := os.OpenFile(l.logPath(l.generation), os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	l.log = log
+	return durable.SyncDir(l.dir)
+}
+
+// writeSnapshot saves the list under the given generation: a header record with the generation and the number of items, followed by one record per item.
+func (l *durableList[T]) writeSnapshot(generation uint64) error {
+	return durable.WriteFileWith(filepath.Join(l.dir, snapshotFile), func(w io.Writer) error {
+		var header [16]byte
+		binary.LittleEndian.PutUint64(header[0:8], generation)
+		binary.LittleEndian.PutUint64(header[8:16], uint64(l.list.Size()))
+		if err := durable.AppendRecord(w, header[:]); err != nil {
+			return err
+		}
+		for _, item := range l.list.ToSlice() {
+			data, err := l.options.Codec.Marshal(item)
+			if err != nil {
+				return err
+			}
+			if err := durable.AppendRecord(w, data); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// readSnapshot loads the snapshot into the list and returns its generation, 1 when there is no snapshot yet.
+func (l *durableList[T]) readSnapshot() (uint64, error) {
+	file, err := os.Open(filepath.Join(l.dir, snapshotFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return 1, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	reader := durable.NewReader(file)
+	header, err := reader.Next()
+	if err != nil || len(header) != 16 {
+		return 0, fmt.Errorf("%w: snapshot header", ErrCorrupt)
+	}
+	generation := binary.LittleEndian.Uint64(header[0:8])
+	count := binary.LittleEndian.Uint64(header[8:16])
+	for i := uint64(0); i < count; i++ {
+		data, err := reader.Next()
+		if err != nil {
+			return 0, fmt.Errorf("%w: snapshot item %d: %v", ErrCorrupt, i, err)
+		}
+		item, err := l.options.Codec.Unmarshal(data)
+		if err != nil {
+			return 0, fmt.Errorf("%w: snapshot item %d: %v", ErrCorrupt, i, err)
+		}
+		l.list.AddLast(item)
+	}
+	if generation == 0 {
+		return 0, fmt.Errorf("%w: snapshot generation", ErrCorrupt)
+	}
+	return generation, nil
+}
+
+// listLogs returns the generations of the log files in the directory in ascending order.
+func (l *durableList[T]) listLogs() ([]uint64, error) {
+	entries, err := os.ReadDir(l.dir)
+	if err != nil {
+		return nil, err
+	}
+	logs := []uint64{}
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), logSuffix)
+		if !ok || entry.IsDir() {
+			continue
+		}
+		if generation, err := strconv.ParseUint(name, 10, 64); err == nil {
+			logs = append(logs, generation)
+		}
+	}
+	slices.Sort(logs)
+	return logs, nil
+}
+
+func (l *durableList[T]) logPath(generation uint64) string {
+	return filepath.Join(l.dir, fmt.Sprintf("%020d%s", generation, logSuffix))
+}
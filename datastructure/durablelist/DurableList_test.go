@@ -0,0 +1,394 @@
+package durablelist
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/OmarFaruk-0x01/go_algorithms/datastructure/diskqueue"
+	"github.com/OmarFaruk-0x01/go_algorithms/datastructure/internal/durable"
+	"github.com/stretchr/testify/assert"
+)
+
+type task struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+func open(t *testing.T, dir string, options Options[int]) *durableList[int] {
+	t.Helper()
+	l, err := Open(dir, options)
+	assert.Nil(t, err)
+	if l == nil {
+		t.FailNow()
+	}
+	return l
+}
+
+func logFiles(t *testing.T, dir string) []string {
+	t.Helper()
+	matches, err := filepath.Glob(filepath.Join(dir, "*"+logSuffix))
+	assert.Nil(t, err)
+	slices.Sort(matches)
+	return matches
+}
+
+// mutate runs every kind of mutation, leaving the list at 0 -> 5 -> 2 -> 3 -> 6 -> 7.
+func mutate(t *testing.T, l *durableList[int]) {
+	t.Helper()
+	assert.Nil(t, l.AddLast(2))
+	assert.Nil(t, l.AddLast(3))
+	assert.Nil(t, l.AddFirst(1))
+	assert.Nil(t, l.AddFirst(0))
+	assert.Nil(t, l.InsertAt(4, 2))
+	assert.Nil(t, l.AddLast(9))
+	assert.Nil(t, l.RemoveAt(1))
+	assert.Nil(t, l.InsertAt(5, 1))
+	assert.Nil(t, l.RemoveAt(2))
+	assert.Nil(t, l.RemoveLast())
+	assert.Nil(t, l.AddLast(6))
+	assert.Nil(t, l.AddFirst(8))
+	assert.Nil(t, l.RemoveFirst())
+	assert.Nil(t, l.InsertAt(7, 5))
+	assert.Equal(t, []int{0, 5, 2, 3, 6, 7}, l.ToSlice())
+}
+
+func TestReopen(t *testing.T) {
+	dir := t.TempDir()
+	l, err := Open(dir, Options[task]{})
+	assert.Nil(t, err)
+	assert.Nil(t, l.AddLast(task{1, "build"}))
+	assert.Nil(t, l.AddLast(task{2, "test"}))
+	assert.Nil(t, l.AddFirst(task{0, "plan"}))
+	assert.Nil(t, l.Close())
+	assert.ErrorIs(t, l.AddLast(task{3, "late"}), ErrClosed)
+	assert.ErrorIs(t, l.RemoveFirst(), ErrClosed)
+	assert.Equal(t, 3, l.Size())
+
+	l, err = Open(dir, Options[task]{})
+	assert.Nil(t, err)
+	defer l.Close()
+	assert.Equal(t, []task{{0, "plan"}, {1, "build"}, {2, "test"}}, l.ToSlice())
+	first, _ := l.First()
+	assert.Equal(t, task{0, "plan"}, first)
+	last, _ := l.Last()
+	assert.Equal(t, task{2, "test"}, last)
+	assert.Equal(t, 1, l.IndexFunc(func(item task) bool { return item.Name == "build" }))
+	assert.True(t, l.ContainsFunc(func(item task) bool { return item.ID == 2 }))
+	assert.Equal(t, "[{0 plan} {1 build} {2 test}]", l.String())
+}
+
+func TestCrashWithoutClose(t *testing.T) {
+	dir := t.TempDir()
+	l := open(t, dir, Options[int]{})
+	mutate(t, l)
+	// The process dies here: the log is never closed.
+
+	recovered := open(t, dir, Options[int]{})
+	defer recovered.Close()
+	assert.Equal(t, []int{0, 5, 2, 3, 6, 7}, recovered.ToSlice())
+	assert.Equal(t, 14, recovered.logged)
+}
+
+func TestFailedMutationsAreNotLogged(t *testing.T) {
+	dir := t.TempDir()
+	l := open(t, dir, Options[int]{})
+	assert.ErrorIs(t, l.RemoveFirst(), ErrEmpty)
+	assert.ErrorIs(t, l.RemoveLast(), ErrEmpty)
+	assert.ErrorIs(t, l.RemoveAt(0), ErrEmpty)
+	assert.Nil(t, l.AddLast(1))
+	assert.ErrorIs(t, l.RemoveAt(1), ErrIndexOutOfRange)
+	assert.ErrorIs(t, l.RemoveAt(-1), ErrIndexOutOfRange)
+	assert.ErrorIs(t, l.InsertAt(2, -1), ErrIndexOutOfRange)
+	assert.ErrorIs(t, l.InsertAt(2, 2), ErrIndexOutOfRange)
+	assert.Equal(t, []int{1}, l.ToSlice())
+	assert.Equal(t, 1, l.logged)
+	assert.Nil(t, l.Close())
+
+	l = open(t, dir, Options[int]{})
+	defer l.Close()
+	assert.Equal(t, []int{1}, l.ToSlice())
+}
+
+func TestFailedWriteBreaksList(t *testing.T) {
+	dir := t.TempDir()
+	l := open(t, dir, Options[int]{})
+	assert.Nil(t, l.AddLast(1))
+	writable := l.log
+
+	// A log that can neither be written nor cut back stands in for a failing disk.
+	readOnly, err := os.Open(l.logPath(l.generation))
+	assert.Nil(t, err)
+	l.log = readOnly
+	err = l.AddLast(2)
+	assert.NotNil(t, err)
+	assert.ErrorIs(t, err, ErrBroken)
+	assert.Equal(t, []int{1}, l.ToSlice())
+	assert.ErrorIs(t, l.AddFirst(0), ErrBroken)
+	assert.ErrorIs(t, l.RemoveFirst(), ErrBroken)
+	assert.ErrorIs(t, l.Snapshot(), ErrBroken)
+	readOnly.Close()
+	writable.Close()
+
+	l = open(t, dir, Options[int]{})
+	defer l.Close()
+	assert.Equal(t, []int{1}, l.ToSlice())
+}
+
+func TestRecoverTornTail(t *testing.T) {
+	dir := t.TempDir()
+	l := open(t, dir, Options[int]{})
+	assert.Nil(t, l.AddLast(1))
+	assert.Nil(t, l.AddLast(2))
+	assert.Nil(t, l.AddLast(3))
+	assert.Nil(t, l.Close())
+	logs := logFiles(t, dir)
+	info, _ := os.Stat(logs[0])
+	assert.Nil(t, os.Truncate(logs[0], info.Size()-1))
+
+	l = open(t, dir, Options[int]{})
+	assert.Equal(t, []int{1, 2}, l.ToSlice())
+
+	// New mutations go right after the last complete record.
+	assert.Nil(t, l.AddFirst(0))
+	assert.Nil(t, l.Close())
+	l = open(t, dir, Options[int]{})
+	defer l.Close()
+	assert.Equal(t, []int{0, 1, 2}, l.ToSlice())
+}
+
+func TestRecoverCorruptTail(t *testing.T) {
+	dir := t.TempDir()
+	l := open(t, dir, Options[int]{})
+	assert.Nil(t, l.AddLast(1))
+	assert.Nil(t, l.AddLast(2))
+	assert.Nil(t, l.Close())
+	logs := logFiles(t, dir)
+	data, _ := os.ReadFile(logs[0])
+	data[len(data)-1] ^= 0xff
+	os.WriteFile(logs[0], data, 0o644)
+
+	l = open(t, dir, Options[int]{})
+	defer l.Close()
+	assert.Equal(t, []int{1}, l.ToSlice())
+}
+
+func TestCorruptionInsideLogFailsOpen(t *testing.T) {
+	dir := t.TempDir()
+	l := open(t, dir, Options[int]{})
+	for i := range 10 {
+		assert.Nil(t, l.AddLast(i))
+	}
+	assert.Nil(t, l.Close())
+	logs := logFiles(t, dir)
+	data, _ := os.ReadFile(logs[0])
+	data[durable.HeaderSize] ^= 0xff
+	os.WriteFile(logs[0], data, 0o644)
+
+	_, err := Open(dir, Options[int]{})
+	assert.ErrorIs(t, err, ErrCorrupt)
+	after, _ := os.ReadFile(logs[0])
+	assert.Equal(t, data, after)
+}
+
+func TestSnapshot(t *testing.T) {
+	dir := t.TempDir()
+	l := open(t, dir, Options[int]{})
+	mutate(t, l)
+	assert.Nil(t, l.Snapshot())
+	assert.Equal(t, uint64(2), l.generation)
+	assert.Equal(t, 0, l.logged)
+	assert.Equal(t, []string{l.logPath(2)}, logFiles(t, dir))
+
+	assert.Nil(t, l.RemoveAt(1))
+	assert.Nil(t, l.AddLast(4))
+	assert.Nil(t, l.Close())
+
+	l = open(t, dir, Options[int]{})
+	defer l.Close()
+	assert.Equal(t, []int{0, 2, 3, 6, 7, 4}, l.ToSlice())
+	assert.Equal(t, 2, l.logged)
+}
+
+func TestSnapshotOfEmptyList(t *testing.T) {
+	dir := t.TempDir()
+	l := open(t, dir, Options[int]{})
+	assert.Nil(t, l.AddLast(1))
+	assert.Nil(t, l.RemoveLast())
+	assert.Nil(t, l.Snapshot())
+	assert.Nil(t, l.Close())
+
+	l = open(t, dir, Options[int]{})
+	defer l.Close()
+	assert.Equal(t, 0, l.Size())
+	assert.Equal(t, []int{}, l.ToSlice())
+}
+
+func TestAutomaticSnapshot(t *testing.T) {
+	dir := t.TempDir()
+	l := open(t, dir, Options[int]{SnapshotEvery: 4})
+	for i := range 10 {
+		assert.Nil(t, l.AddLast(i))
+	}
+	assert.Equal(t, uint64(3), l.generation)
+	assert.Equal(t, 2, l.logged)
+
+	recovered := open(t, dir, Options[int]{SnapshotEvery: 4})
+	defer recovered.Close()
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, recovered.ToSlice())
+	assert.Equal(t, []string{recovered.logPath(3)}, logFiles(t, dir))
+
+	disabled := open(t, t.TempDir(), Options[int]{SnapshotEvery: -1})
+	defer disabled.Close()
+	for i := range 10 {
+		assert.Nil(t, disabled.AddLast(i))
+	}
+	assert.Equal(t, uint64(1), disabled.generation)
+}
+
+func TestFailedAutomaticSnapshot(t *testing.T) {
+	dir := t.TempDir()
+	// A non-empty directory where the snapshot is written first makes every snapshot fail.
+	blocker := filepath.Join(dir, snapshotFile+".tmp")
+	assert.Nil(t, os.MkdirAll(filepath.Join(blocker, "keep"), 0o755))
+
+	l := open(t, dir, Options[int]{SnapshotEvery: 2})
+	assert.Nil(t, l.AddLast(1))
+	assert.Nil(t, l.SnapshotErr())
+	assert.Nil(t, l.AddLast(2))
+	assert.NotNil(t, l.SnapshotErr())
+	assert.Nil(t, l.AddLast(3))
+	assert.NotNil(t, l.SnapshotErr())
+	assert.Equal(t, uint64(1), l.generation)
+	assert.Equal(t, []int{1, 2, 3}, l.ToSlice())
+	assert.Nil(t, l.Close())
+
+	// Every mutation was applied once, so reopening does not repeat any of them.
+	l = open(t, dir, Options[int]{SnapshotEvery: 2})
+	assert.Equal(t, []int{1, 2, 3}, l.ToSlice())
+
+	assert.Nil(t, os.RemoveAll(blocker))
+	assert.Nil(t, l.AddLast(4))
+	assert.Nil(t, l.SnapshotErr())
+	assert.Equal(t, uint64(2), l.generation)
+	assert.Nil(t, l.Close())
+
+	l = open(t, dir, Options[int]{})
+	defer l.Close()
+	assert.Equal(t, []int{1, 2, 3, 4}, l.ToSlice())
+}
+
+func TestCrashDuringSnapshot(t *testing.T) {
+	dir := t.TempDir()
+	l := open(t, dir, Options[int]{})
+	mutate(t, l)
+	oldLog := l.logPath(1)
+	data, _ := os.ReadFile(oldLog)
+
+	// The snapshot was written but the process died before the new log was created and the old one removed.
+	assert.Nil(t, l.Snapshot())
+	assert.Nil(t, l.Close())
+	os.Remove(l.logPath(2))
+	os.WriteFile(oldLog, data, 0o644)
+
+	l = open(t, dir, Options[int]{})
+	assert.Equal(t, []int{0, 5, 2, 3, 6, 7}, l.ToSlice())
+	assert.Equal(t, []string{l.logPath(2)}, logFiles(t, dir))
+	assert.Nil(t, l.AddLast(8))
+	assert.Nil(t, l.Close())
+
+	l = open(t, dir, Options[int]{})
+	defer l.Close()
+	assert.Equal(t, []int{0, 5, 2, 3, 6, 7, 8}, l.ToSlice())
+}
+
+func TestCorruptionFailsOpen(t *testing.T) {
+	dir := t.TempDir()
+	l := open(t, dir, Options[int]{})
+	mutate(t, l)
+	assert.Nil(t, l.Snapshot())
+	assert.Nil(t, l.Close())
+
+	// A log newer than the snapshot can only come from a damaged directory.
+	os.WriteFile(l.logPath(3), nil, 0o644)
+	_, err := Open(dir, Options[int]{})
+	assert.ErrorIs(t, err, ErrCorrupt)
+	os.Remove(l.logPath(3))
+
+	// A logged removal that can not be replayed means the log does not belong to the snapshot.
+	var log bytes.Buffer
+	durable.AppendRecord(&log, []byte{byte(opRemoveAt), 10})
+	os.WriteFile(l.logPath(2), log.Bytes(), 0o644)
+	_, err = Open(dir, Options[int]{})
+	assert.ErrorIs(t, err, ErrCorrupt)
+	os.WriteFile(l.logPath(2), nil, 0o644)
+
+	snapshot := filepath.Join(dir, snapshotFile)
+	data, _ := os.ReadFile(snapshot)
+	data[len(data)-1] ^= 0xff
+	os.WriteFile(snapshot, data, 0o644)
+	_, err = Open(dir, Options[int]{})
+	assert.ErrorIs(t, err, ErrCorrupt)
+}
+
+func TestCodec(t *testing.T) {
+	dir := t.TempDir()
+	options := Options[task]{Codec: diskqueue.GobCodec[task]{}, SnapshotEvery: 2}
+	l, err := Open(dir, options)
+	assert.Nil(t, err)
+	assert.Nil(t, l.AddLast(task{1, "build"}))
+	assert.Nil(t, l.AddLast(task{2, "test"}))
+	assert.Nil(t, l.InsertAt(task{3, "lint"}, 1))
+	assert.Nil(t, l.Close())
+
+	l, err = Open(dir, options)
+	assert.Nil(t, err)
+	defer l.Close()
+	assert.Equal(t, []task{{1, "build"}, {3, "lint"}, {2, "test"}}, slices.Collect(l.Values()))
+	for index, item := range l.All() {
+		assert.Equal(t, l.ToSlice()[index], item)
+	}
+}
+
+// padCodec encodes an int as that many zero bytes.
+type padCodec struct{}
+
+func (padCodec) Marshal(item int) ([]byte, error) {
+	return make([]byte, item), nil
+}
+
+func (padCodec) Unmarshal(data []byte) (int, error) {
+	return len(data), nil
+}
+
+func TestItemTooLarge(t *testing.T) {
+	dir := t.TempDir()
+	l := open(t, dir, Options[int]{Codec: padCodec{}})
+	assert.Nil(t, l.AddLast(3))
+	err := l.AddLast(durable.MaxPayloadSize)
+	assert.ErrorIs(t, err, ErrTooLarge)
+	assert.NotErrorIs(t, err, ErrCorrupt)
+	assert.Nil(t, l.AddFirst(1))
+	assert.Nil(t, l.Close())
+
+	l = open(t, dir, Options[int]{Codec: padCodec{}})
+	defer l.Close()
+	assert.Equal(t, []int{1, 3}, l.ToSlice())
+}
+
+func TestNoSync(t *testing.T) {
+	dir := t.TempDir()
+	l := open(t, dir, Options[int]{NoSync: true})
+	mutate(t, l)
+	assert.Nil(t, l.Sync())
+	assert.Nil(t, l.Close())
+	assert.ErrorIs(t, l.Sync(), ErrClosed)
+	assert.ErrorIs(t, l.Snapshot(), ErrClosed)
+	assert.Nil(t, l.Close())
+
+	l = open(t, dir, Options[int]{NoSync: true})
+	defer l.Close()
+	assert.Equal(t, []int{0, 5, 2, 3, 6, 7}, l.ToSlice())
+}
@@ -0,0 +1,34 @@
+package durablelist
+
+import "github.com/OmarFaruk-0x01/go_algorithms/datastructure/internal/durable"
+
+const defaultSnapshotEvery = 10000
+
+// Codec converts the items to and from the bytes stored in the log and the snapshot. The codecs of the diskqueue package fit.
+type Codec[T any] interface {
+	durable.Codec[T]
+}
+
+// Options tunes a durable list. The zero value is ready to use.
+//
+// Fields:
+//
+//	SnapshotEvery: The number of logged mutations after which a snapshot is taken on its own, 10000 when 0, never when negative.
+//	NoSync: Skip the fsync after every mutation. Mutations since the last Sync, Snapshot or Close may then be lost in a crash, the list is still rebuilt consistently up to some earlier mutation.
+//	Codec: How items are stored, JSON when nil.
+type Options[T any] struct {
+	SnapshotEvery int
+	NoSync        bool
+	Codec         Codec[T]
+}
+
+// withDefaults fills in the fields left at their zero value.
+func (o Options[T]) withDefaults() Options[T] {
+	if o.SnapshotEvery == 0 {
+		o.SnapshotEvery = defaultSnapshotEvery
+	}
+	if o.Codec == nil {
+		o.Codec = durable.JSONCodec[T]{}
+	}
+	return o
+}
@@ -0,0 +1,45 @@
+// Package errs holds the errors shared by every container of the datastructure packages, so callers can check them with errors.Is and errors.As no matter which container returned them.
+package errs
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrEmpty is returned when an item is read or removed from an empty container.
+	ErrEmpty = errors.New("container is empty")
+	// ErrFull is returned when an item is added to a container that reached its max.
+	ErrFull = errors.New("container is full")
+	// ErrNotFound is returned when no item matches what was searched for.
+	ErrNotFound = errors.New("no item matches the predicate")
+	// ErrClosed is returned when a closed container is used.
+	ErrClosed = errors.New("container is closed")
+	// ErrIndexOutOfRange is matched by every IndexOutOfRangeError.
+	ErrIndexOutOfRange = errors.New("index out of range")
+)
+
+// IndexOutOfRangeError is returned when an index is negative or not smaller than the size of the container.
+//
+// Fields:
+//
+//	Index: The offending index.
+//	Size: The size of the container when the index was used.
+type IndexOutOfRangeError struct {
+	Index int
+	Size  int
+}
+
+// IndexOutOfRange creates an IndexOutOfRangeError for the given index and size.
+func IndexOutOfRange(index int, size int) error {
+	return &IndexOutOfRangeError{Index: index, Size: size}
+}
+
+func (e *IndexOutOfRangeError) Error() string {
+	return fmt.Sprintf("index %d out of range for size %d", e.Index, e.Size)
+}
+
+// Is makes errors.Is(err, ErrIndexOutOfRange) report true for every IndexOutOfRangeError.
+func (e *IndexOutOfRangeError) Is(target error) bool {
+	return target == ErrIndexOutOfRange
+}
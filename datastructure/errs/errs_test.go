@@ -0,0 +1,22 @@
+package errs
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndexOutOfRange(t *testing.T) {
+	err := fmt.Errorf("remove: %w", IndexOutOfRange(5, 3))
+
+	assert.True(t, errors.Is(err, ErrIndexOutOfRange))
+	assert.False(t, errors.Is(err, ErrEmpty))
+
+	var indexErr *IndexOutOfRangeError
+	assert.True(t, errors.As(err, &indexErr))
+	assert.Equal(t, 5, indexErr.Index)
+	assert.Equal(t, 3, indexErr.Size)
+	assert.Equal(t, "remove: index 5 out of range for size 3", err.Error())
+}
@@ -0,0 +1,58 @@
+package expr
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrUnexpectedCharacter is returned by Tokenize for a character that starts no token.
+	ErrUnexpectedCharacter = errors.New("unexpected character")
+	// ErrInvalidNumber is returned by Tokenize for a malformed number such as 1.2.3.
+	ErrInvalidNumber = errors.New("invalid number")
+	// ErrUnexpectedToken is returned by Parse for a token that can not follow the ones before it.
+	ErrUnexpectedToken = errors.New("unexpected token")
+	// ErrUnexpectedEnd is returned by Parse when the expression stops where an operand is expected.
+	ErrUnexpectedEnd = errors.New("unexpected end of expression")
+	// ErrUnbalancedParenthesis is returned by Parse for a parenthesis without its pair.
+	ErrUnbalancedParenthesis = errors.New("unbalanced parenthesis")
+	// ErrUnknownVariable is returned by Eval for a variable that is not set in the environment.
+	ErrUnknownVariable = errors.New("unknown variable")
+	// ErrUnknownFunction is returned by Eval for a function that is not registered in the environment.
+	ErrUnknownFunction = errors.New("unknown function")
+	// ErrArgumentCount is returned by Eval when a function is called with the wrong number of arguments.
+	ErrArgumentCount = errors.New("wrong number of arguments")
+	// ErrType is returned by Eval when an operator or function gets a number instead of a boolean or the other way around, and by Env.Set for values that are neither.
+	ErrType = errors.New("type mismatch")
+	// ErrDivisionByZero is returned by Eval for / and % with a zero divisor.
+	ErrDivisionByZero = errors.New("division by zero")
+)
+
+// PositionError tells where in the input an error happened. Every error of Tokenize, Parse and Eval is a PositionError wrapping one of the errors above, or the error of a registered function, so both errors.Is and errors.As work on it.
+//
+// Fields:
+//
+//	Pos: The byte offset of the offending token in the input, the length of the input for ErrUnexpectedEnd.
+//	Text: The offending token, empty at the end of the input.
+//	Err: The cause.
+type PositionError struct {
+	Pos  int
+	Text string
+	Err  error
+}
+
+func (e *PositionError) Error() string {
+	if e.Text == "" {
+		return fmt.Sprintf("%v at offset %d", e.Err, e.Pos)
+	}
+	return fmt.Sprintf("%v %q at offset %d", e.Err, e.Text, e.Pos)
+}
+
+func (e *PositionError) Unwrap() error {
+	return e.Err
+}
+
+// errorAt creates a PositionError for the given token.
+func errorAt(token Token, err error) error {
+	return &PositionError{Pos: token.Pos, Text: token.Text, Err: err}
+}
@@ -0,0 +1,344 @@
+package expr
+
+import (
+	"math"
+
+	"github.com/OmarFaruk-0x01/go_algorithms/datastructure/stack"
+)
+
+// Function is a function callable from expressions. Its arguments are float64 and bool values and it has to return one of them too, any other integer or float type is converted to float64.
+type Function func(args ...any) (any, error)
+
+// function is a registered Function with the number of arguments it takes, any number when negative.
+type function struct {
+	arity int
+	call  Function
+}
+
+// Env holds the variables and functions an expression is evaluated with. The zero value is an empty environment without the built-in functions. It is not safe to change an Env while it is used by Eval in another goroutine.
+//
+// Fields:
+//
+//	variables: The values of the variables by name, normalized to float64 or bool.
+//	functions: The registered functions by name.
+type Env struct {
+	variables map[string]any
+	functions map[string]function
+}
+
+// NewEnv creates an environment without variables and with the built-in functions abs, sqrt, floor, ceil, round, min and max.
+//
+// Example:
+//
+//	env := expr.NewEnv()
+//	env.Set("x", 3)
+//	env.Register("double", 1, func(args ...any) (any, error) {
+//		return args[0].(float64) * 2, nil
+//	})
+//	result, _ := expr.Eval("double(x) + 1", env) // result: 7.0
+func NewEnv() *Env {
+	e := &Env{variables: map[string]any{}, functions: map[string]function{}}
+	for name, apply := range map[string]func(float64) float64{
+		"abs":   math.Abs,
+		"sqrt":  math.Sqrt,
+		"floor": math.Floor,
+		"ceil":  math.Ceil,
+		"round": math.Round,
+	} {
+		e.Register(name, 1, func(args ...any) (any, error) {
+			number, ok := args[0].(float64)
+			if !ok {
+				return nil, ErrType
+			}
+			return apply(number), nil
+		})
+	}
+	e.Register("min", -1, extreme(math.Min))
+	e.Register("max", -1, extreme(math.Max))
+	return e
+}
+
+// Set sets a variable to a number or a boolean. Every integer and float type is stored as float64, any other type is rejected with ErrType.
+func (e *Env) Set(name string, value any) error {
+	normalized, ok := normalize(value)
+	if !ok {
+		return ErrType
+	}
+	if e.variables == nil {
+		e.variables = map[string]any{}
+	}
+	e.variables[name] = normalized
+	return nil
+}
+
+// Get returns the value of a variable and whether it is set.
+func (e *Env) Get(name string) (any, bool) {
+	value, ok := e.variables[name]
+	return value, ok
+}
+
+// Unset removes a variable.
+func (e *Env) Unset(name string) {
+	delete(e.variables, name)
+}
+
+// Register adds a function or replaces the one with the same name. Calls with a number of arguments other than arity fail with ErrArgumentCount before the function runs, a negative arity accepts any number.
+func (e *Env) Register(name string, arity int, call Function) {
+	if e.functions == nil {
+		e.functions = map[string]function{}
+	}
+	e.functions[name] = function{arity: arity, call: call}
+}
+
+// Eval parses the input and evaluates it in the environment, a nil environment has no variables and no functions. To evaluate the same input many times, Parse it once and call Expression.Eval instead.
+func Eval(input string, env *Env) (any, error) {
+	e, err := Parse(input)
+	if err != nil {
+		return nil, err
+	}
+	return e.Eval(env)
+}
+
+// Eval evaluates the expression in the environment, a nil environment has no variables and no functions. The result is a float64 or a bool. Errors are returned as a PositionError pointing at the operator, variable or function that failed.
+//
+// Example:
+//
+//	e := expr.MustParse("a > 1 && b")
+//	env := expr.NewEnv()
+//	env.Set("a", 2)
+//	env.Set("b", true)
+//	result, _ := e.Eval(env) // result: true
+func (e *Expression) Eval(env *Env) (any, error) {
+	if env == nil {
+		env = &Env{}
+	}
+	values := stack.New[any]()
+	for pc := 0; pc < len(e.program); pc++ {
+		instruction := e.program[pc]
+		switch instruction.op {
+		case opNumber:
+			values.Push(instruction.number)
+		case opBool:
+			values.Push(instruction.boolean)
+		case opVariable:
+			value, ok := env.variables[instruction.token.Text]
+			if !ok {
+				return nil, errorAt(instruction.token, ErrUnknownVariable)
+			}
+			values.Push(value)
+		case opUnary:
+			operand, _ := values.Pop()
+			result, err := unary(instruction.token.Text, operand)
+			if err != nil {
+				return nil, errorAt(instruction.token, err)
+			}
+			values.Push(result)
+		case opBinary:
+			right, _ := values.Pop()
+			left, _ := values.Pop()
+			result, err := binary(instruction.token.Text, left, right)
+			if err != nil {
+				return nil, errorAt(instruction.token, err)
+			}
+			values.Push(result)
+		case opCall:
+			result, err := env.call(instruction, values)
+			if err != nil {
+				return nil, err
+			}
+			values.Push(result)
+		case opJumpIfFalse, opJumpIfTrue:
+			// The left operand stays on the stack: it is the result when the jump is taken, and the left operand of the operator otherwise.
+			left, _ := values.Peek()
+			decided, ok := left.(bool)
+			if !ok {
+				return nil, errorAt(instruction.token, ErrType)
+			}
+			if decided == (instruction.op == opJumpIfTrue) {
+				pc = instruction.target - 1
+			}
+		}
+	}
+	result, _ := values.Pop()
+	return result, nil
+}
+
+// EvalNumber evaluates the expression and fails with ErrType unless the result is a number. The error then points at the whole input.
+func (e *Expression) EvalNumber(env *Env) (float64, error) {
+	result, err := e.Eval(env)
+	if err != nil {
+		return 0, err
+	}
+	number, ok := result.(float64)
+	if !ok {
+		return 0, &PositionError{Text: e.input, Err: ErrType}
+	}
+	return number, nil
+}
+
+// EvalBool evaluates the expression and fails with ErrType unless the result is a boolean.
+func (e *Expression) EvalBool(env *Env) (bool, error) {
+	result, err := e.Eval(env)
+	if err != nil {
+		return false, err
+	}
+	boolean, ok := result.(bool)
+	if !ok {
+		return false, &PositionError{Text: e.input, Err: ErrType}
+	}
+	return boolean, nil
+}
+
+// call pops the arguments of a function call from the values, calls the function and checks its result.
+func (e *Env) call(instruction instruction, values stack.Stack[any]) (any, error) {
+	args := make([]any, instruction.arity)
+	for i := instruction.arity - 1; i >= 0; i-- {
+		args[i], _ = values.Pop()
+	}
+	function, ok := e.functions[instruction.token.Text]
+	if !ok {
+		return nil, errorAt(instruction.token, ErrUnknownFunction)
+	}
+	if function.arity >= 0 && function.arity != instruction.arity {
+		return nil, errorAt(instruction.token, ErrArgumentCount)
+	}
+	result, err := function.call(args...)
+	if err != nil {
+		return nil, errorAt(instruction.token, err)
+	}
+	normalized, ok := normalize(result)
+	if !ok {
+		return nil, errorAt(instruction.token, ErrType)
+	}
+	return normalized, nil
+}
+
+func unary(operator string, operand any) (any, error) {
+	if operator == "!" {
+		boolean, ok := operand.(bool)
+		if !ok {
+			return nil, ErrType
+		}
+		return !boolean, nil
+	}
+	number, ok := operand.(float64)
+	if !ok {
+		return nil, ErrType
+	}
+	if operator == "-" {
+		return -number, nil
+	}
+	return number, nil
+}
+
+func binary(operator string, left any, right any) (any, error) {
+	switch operator {
+	case "&&", "||":
+		l, lok := left.(bool)
+		r, rok := right.(bool)
+		if !lok || !rok {
+			return nil, ErrType
+		}
+		if operator == "&&" {
+			return l && r, nil
+		}
+		return l || r, nil
+	case "==", "!=":
+		if isBool(left) != isBool(right) {
+			return nil, ErrType
+		}
+		return (left == right) == (operator == "=="), nil
+	}
+
+	l, lok := left.(float64)
+	r, rok := right.(float64)
+	if !lok || !rok {
+		return nil, ErrType
+	}
+	switch operator {
+	case "+":
+		return l + r, nil
+	case "-":
+		return l - r, nil
+	case "*":
+		return l * r, nil
+	case "/":
+		if r == 0 {
+			return nil, ErrDivisionByZero
+		}
+		return l / r, nil
+	case "%":
+		if r == 0 {
+			return nil, ErrDivisionByZero
+		}
+		return math.Mod(l, r), nil
+	case "^":
+		return math.Pow(l, r), nil
+	case "<":
+		return l < r, nil
+	case "<=":
+		return l <= r, nil
+	case ">":
+		return l > r, nil
+	default:
+		return l >= r, nil
+	}
+}
+
+// extreme creates the variadic min or max function, it takes at least one number.
+func extreme(pick func(a, b float64) float64) Function {
+	return func(args ...any) (any, error) {
+		if len(args) == 0 {
+			return nil, ErrArgumentCount
+		}
+		result, ok := args[0].(float64)
+		if !ok {
+			return nil, ErrType
+		}
+		for _, arg := range args[1:] {
+			number, ok := arg.(float64)
+			if !ok {
+				return nil, ErrType
+			}
+			result = pick(result, number)
+		}
+		return result, nil
+	}
+}
+
+// normalize converts every integer and float type to float64 and reports false for anything that is not a number or a boolean.
+func normalize(value any) (any, bool) {
+	switch v := value.(type) {
+	case float64, bool:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int8:
+		return float64(v), true
+	case int16:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint:
+		return float64(v), true
+	case uint8:
+		return float64(v), true
+	case uint16:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	default:
+		return nil, false
+	}
+}
+
+func isBool(value any) bool {
+	_, ok := value.(bool)
+	return ok
+}
@@ -0,0 +1,196 @@
+package expr
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEval(t *testing.T) {
+	env := NewEnv()
+	env.Set("x", 4)
+	env.Set("y", 2.5)
+	env.Set("ok", true)
+	tests := []struct {
+		input  string
+		result any
+	}{
+		{"1 + 2 * 3", 7.0},
+		{"(1 + 2) * 3", 9.0},
+		{"10 - 4 - 3", 3.0},
+		{"2 ^ 3 ^ 2", 512.0},
+		{"-2 ^ 2", -4.0},
+		{"7 % 3 + 7 / 2", 4.5},
+		{"x * y", 10.0},
+		{"-x + +y", -1.5},
+		{"x > y", true},
+		{"x <= 4 && y >= 2.5", true},
+		{"x < 4 || y > 3", false},
+		{"!ok", false},
+		{"ok == (x == 4)", true},
+		{"ok != false", true},
+		{"max(1, x, y) + min(3, -1)", 3.0},
+		{"abs(-3) + sqrt(x) + floor(y) + ceil(y) + round(y)", 3.0 + 2 + 2 + 3 + 3},
+		{"true", true},
+		{"1e3", 1000.0},
+	}
+	for _, test := range tests {
+		result, err := Eval(test.input, env)
+		if assert.Nil(t, err, test.input) {
+			assert.Equal(t, test.result, result, test.input)
+		}
+	}
+}
+
+func TestShortCircuit(t *testing.T) {
+	env := NewEnv()
+	env.Set("x", 0)
+	calls := 0
+	env.Register("touch", 0, func(args ...any) (any, error) {
+		calls++
+		return true, nil
+	})
+
+	result, err := Eval("x != 0 && 1 / x > 2", env)
+	assert.Nil(t, err)
+	assert.Equal(t, false, result)
+	result, err = Eval("x == 0 || 1 / x > 2", env)
+	assert.Nil(t, err)
+	assert.Equal(t, true, result)
+
+	for _, input := range []string{"false && touch()", "true || touch()", "false && touch() && touch()", "true || false && touch()"} {
+		result, err := Eval(input, env)
+		assert.Nil(t, err, input)
+		assert.Equal(t, input[0] == 't', result, input)
+	}
+	assert.Equal(t, 0, calls)
+
+	result, err = Eval("true && touch() || touch()", env)
+	assert.Nil(t, err)
+	assert.Equal(t, true, result)
+	assert.Equal(t, 1, calls)
+	result, err = Eval("false || (x == 0 && touch())", env)
+	assert.Nil(t, err)
+	assert.Equal(t, true, result)
+	assert.Equal(t, 2, calls)
+}
+
+func TestFunctions(t *testing.T) {
+	env := NewEnv()
+	env.Register("clamp", 3, func(args ...any) (any, error) {
+		value, low, high := args[0].(float64), args[1].(float64), args[2].(float64)
+		return math.Max(low, math.Min(value, high)), nil
+	})
+	env.Register("count", -1, func(args ...any) (any, error) {
+		return len(args), nil
+	})
+	env.Register("even", 1, func(args ...any) (any, error) {
+		return math.Mod(args[0].(float64), 2) == 0, nil
+	})
+
+	result, err := Eval("clamp(15, 0, 10) + count() + count(1, true, 3)", env)
+	assert.Nil(t, err)
+	assert.Equal(t, 13.0, result)
+	result, err = Eval("even(count(1, 2)) && !even(3)", env)
+	assert.Nil(t, err)
+	assert.Equal(t, true, result)
+
+	// Registering again replaces the function.
+	env.Register("count", 0, func(args ...any) (any, error) { return 0, nil })
+	result, _ = Eval("count()", env)
+	assert.Equal(t, 0.0, result)
+}
+
+func TestEvalErrors(t *testing.T) {
+	errFailed := errors.New("failed")
+	env := NewEnv()
+	env.Set("x", 1)
+	env.Set("flag", false)
+	env.Register("fail", 0, func(args ...any) (any, error) { return nil, errFailed })
+	env.Register("text", 0, func(args ...any) (any, error) { return "text", nil })
+	tests := []struct {
+		input string
+		err   error
+		pos   int
+		text  string
+	}{
+		{"x + y", ErrUnknownVariable, 4, "y"},
+		{"1 + nope(2)", ErrUnknownFunction, 4, "nope"},
+		{"abs(1, 2)", ErrArgumentCount, 0, "abs"},
+		{"max()", ErrArgumentCount, 0, "max"},
+		{"x / (x - 1)", ErrDivisionByZero, 2, "/"},
+		{"x % 0", ErrDivisionByZero, 2, "%"},
+		{"x + flag", ErrType, 2, "+"},
+		{"-flag", ErrType, 0, "-"},
+		{"!x", ErrType, 0, "!"},
+		{"x == flag", ErrType, 2, "=="},
+		{"x && flag", ErrType, 2, "&&"},
+		{"flag || x", ErrType, 5, "||"},
+		{"abs(flag)", ErrType, 0, "abs"},
+		{"max(1, flag)", ErrType, 0, "max"},
+		{"2 * fail()", errFailed, 4, "fail"},
+		{"text()", ErrType, 0, "text"},
+	}
+	for _, test := range tests {
+		_, err := Eval(test.input, env)
+		assert.ErrorIs(t, err, test.err, test.input)
+		var positionErr *PositionError
+		if assert.True(t, errors.As(err, &positionErr), test.input) {
+			assert.Equal(t, test.pos, positionErr.Pos, test.input)
+			assert.Equal(t, test.text, positionErr.Text, test.input)
+		}
+	}
+
+	_, err := Eval("1 +", env)
+	assert.ErrorIs(t, err, ErrUnexpectedEnd)
+	_, err = Eval("x", nil)
+	assert.ErrorIs(t, err, ErrUnknownVariable)
+	_, err = Eval("abs(1)", &Env{})
+	assert.ErrorIs(t, err, ErrUnknownFunction)
+}
+
+func TestEnv(t *testing.T) {
+	env := &Env{}
+	assert.Nil(t, env.Set("small", int8(3)))
+	assert.Nil(t, env.Set("big", uint64(5)))
+	assert.Nil(t, env.Set("ratio", float32(0.5)))
+	assert.ErrorIs(t, env.Set("name", "text"), ErrType)
+	value, ok := env.Get("small")
+	assert.True(t, ok)
+	assert.Equal(t, 3.0, value)
+	_, ok = env.Get("name")
+	assert.False(t, ok)
+
+	env.Register("twice", 1, func(args ...any) (any, error) { return args[0].(float64) * 2, nil })
+	e := MustParse("twice(small + big) * ratio")
+	result, err := e.Eval(env)
+	assert.Nil(t, err)
+	assert.Equal(t, 8.0, result)
+
+	env.Set("small", 1)
+	result, _ = e.Eval(env)
+	assert.Equal(t, 6.0, result)
+	env.Unset("small")
+	_, err = e.Eval(env)
+	assert.ErrorIs(t, err, ErrUnknownVariable)
+}
+
+func TestEvalNumberAndBool(t *testing.T) {
+	number, err := MustParse("2 * 21").EvalNumber(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 42.0, number)
+	_, err = MustParse("1 < 2").EvalNumber(nil)
+	assert.ErrorIs(t, err, ErrType)
+	_, err = MustParse("x").EvalNumber(nil)
+	assert.ErrorIs(t, err, ErrUnknownVariable)
+
+	boolean, err := MustParse("1 < 2").EvalBool(nil)
+	assert.Nil(t, err)
+	assert.True(t, boolean)
+	_, err = MustParse("1 + 2").EvalBool(nil)
+	assert.ErrorIs(t, err, ErrType)
+	_, err = MustParse("1 / 0 > 1").EvalBool(nil)
+	assert.ErrorIs(t, err, ErrDivisionByZero)
+}
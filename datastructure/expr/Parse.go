@@ -0,0 +1,291 @@
+package expr
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/OmarFaruk-0x01/go_algorithms/datastructure/stack"
+)
+
+// opcode is the kind of an instruction of a compiled expression.
+type opcode int
+
+const (
+	opNumber opcode = iota
+	opBool
+	opVariable
+	opUnary
+	opBinary
+	opCall
+	// opJumpIfFalse and opJumpIfTrue skip the right operand of && and || when the left one decides the result.
+	opJumpIfFalse
+	opJumpIfTrue
+	// opParen only lives on the operator stack while parsing, it never ends up in a program.
+	opParen
+)
+
+// instruction is a step of the program in reverse polish notation, and an entry of the operator stack while parsing.
+//
+// Fields:
+//
+//	op: What the instruction does.
+//	token: The token it was made from, used for its text and for the position of errors.
+//	number: The value of a number literal.
+//	boolean: The value of a bool literal.
+//	arity: The number of arguments of a function call.
+//	target: For a jump, the index of the instruction to continue at. For && and || on the operator stack, the index of their jump.
+//	call: Whether a parenthesis opens the arguments of a function call.
+type instruction struct {
+	op      opcode
+	token   Token
+	number  float64
+	boolean bool
+	arity   int
+	target  int
+	call    bool
+}
+
+// binaryPrecedence maps every binary operator to its precedence, a higher one binds tighter.
+var binaryPrecedence = map[string]int{
+	"||": 1,
+	"&&": 2,
+	"==": 3, "!=": 3,
+	"<": 4, "<=": 4, ">": 4, ">=": 4,
+	"+": 5, "-": 5,
+	"*": 6, "/": 6, "%": 6,
+	"^": 8,
+}
+
+// unaryPrecedence sits between the multiplicative operators and ^, so -2^2 is -(2^2) and 2*-3 works.
+const unaryPrecedence = 7
+
+// Expression is a parsed expression ready to be evaluated any number of times with different environments.
+type Expression struct {
+	input   string
+	program []instruction
+}
+
+// parser holds the state of the shunting-yard algorithm.
+//
+// Fields:
+//
+//	program: The output in reverse polish notation.
+//	operators: The operators, parentheses and function names waiting for their operands.
+//	arguments: The number of arguments seen so far by every open function call, the innermost on top.
+type parser struct {
+	program   []instruction
+	operators stack.Stack[instruction]
+	arguments stack.Stack[int]
+}
+
+// Parse converts the input to reverse polish notation with the shunting-yard algorithm. Syntax errors are returned as a PositionError pointing at the offending token. Variables and functions are only looked up by Eval, so an expression can be parsed once and evaluated in many environments.
+//
+// Example:
+//
+//	e, err := expr.Parse("price * (1 + tax)")
+//	if err != nil {
+//		return err
+//	}
+//	fmt.Println(e) // price 1 tax + *
+func Parse(input string) (*Expression, error) {
+	tokens, err := Tokenize(input)
+	if err != nil {
+		return nil, err
+	}
+	p := parser{operators: stack.New[instruction](), arguments: stack.New[int]()}
+	// expectOperand tells whether the next token has to start an operand, it decides between unary and binary operators.
+	expectOperand := true
+	for i, token := range tokens {
+		switch token.Kind {
+		case Number, Bool:
+			if !expectOperand {
+				return nil, errorAt(token, ErrUnexpectedToken)
+			}
+			if token.Kind == Number {
+				number, _ := strconv.ParseFloat(token.Text, 64)
+				p.program = append(p.program, instruction{op: opNumber, token: token, number: number})
+			} else {
+				p.program = append(p.program, instruction{op: opBool, token: token, boolean: token.Text == "true"})
+			}
+			expectOperand = false
+		case Identifier:
+			if !expectOperand {
+				return nil, errorAt(token, ErrUnexpectedToken)
+			}
+			if i+1 < len(tokens) && tokens[i+1].Kind == LeftParen {
+				p.operators.Push(instruction{op: opCall, token: token})
+				continue
+			}
+			p.program = append(p.program, instruction{op: opVariable, token: token})
+			expectOperand = false
+		case LeftParen:
+			if !expectOperand {
+				return nil, errorAt(token, ErrUnexpectedToken)
+			}
+			call := i > 0 && tokens[i-1].Kind == Identifier
+			p.operators.Push(instruction{op: opParen, token: token, call: call})
+			if call {
+				p.arguments.Push(1)
+			}
+		case Comma:
+			if expectOperand {
+				return nil, errorAt(token, ErrUnexpectedToken)
+			}
+			paren, ok := p.popUntilParen()
+			if !ok || !paren.call {
+				return nil, errorAt(token, ErrUnexpectedToken)
+			}
+			count, _ := p.arguments.Pop()
+			p.arguments.Push(count + 1)
+			expectOperand = true
+		case RightParen:
+			emptyCall := i >= 2 && tokens[i-1].Kind == LeftParen && tokens[i-2].Kind == Identifier
+			if expectOperand && !emptyCall {
+				return nil, errorAt(token, ErrUnexpectedToken)
+			}
+			if _, ok := p.popUntilParen(); !ok {
+				return nil, errorAt(token, ErrUnbalancedParenthesis)
+			}
+			paren, _ := p.operators.Pop()
+			if paren.call {
+				count, _ := p.arguments.Pop()
+				function, _ := p.operators.Pop()
+				function.arity = count
+				if emptyCall {
+					function.arity = 0
+				}
+				p.program = append(p.program, function)
+			}
+			expectOperand = false
+		case Operator:
+			if expectOperand {
+				if token.Text != "-" && token.Text != "+" && token.Text != "!" {
+					return nil, errorAt(token, ErrUnexpectedToken)
+				}
+				// A prefix operator has no left operand, so nothing is popped for it.
+				p.operators.Push(instruction{op: opUnary, token: token})
+				continue
+			}
+			if token.Text == "!" {
+				return nil, errorAt(token, ErrUnexpectedToken)
+			}
+			p.pushBinary(token)
+			expectOperand = true
+		}
+	}
+	if expectOperand {
+		return nil, errorAt(Token{Pos: len(input)}, ErrUnexpectedEnd)
+	}
+	for !p.operators.IsEmpty() {
+		operator, _ := p.operators.Pop()
+		if operator.op == opParen {
+			return nil, errorAt(operator.token, ErrUnbalancedParenthesis)
+		}
+		p.emit(operator)
+	}
+	return &Expression{input: input, program: p.program}, nil
+}
+
+// MustParse is like Parse but panics when the input can not be parsed. It is meant for expressions written in the source code.
+func MustParse(input string) *Expression {
+	e, err := Parse(input)
+	if err != nil {
+		panic(err)
+	}
+	return e
+}
+
+// pushBinary moves the operators that bind at least as tight as the new one to the program and pushes the new one. && and || also get their jump emitted right away, after their left operand.
+func (p *parser) pushBinary(token Token) {
+	current := binaryPrecedence[token.Text]
+	rightAssociative := token.Text == "^"
+	for {
+		top, err := p.operators.Peek()
+		if err != nil || (top.op != opUnary && top.op != opBinary) {
+			break
+		}
+		if precedence(top) < current || (precedence(top) == current && rightAssociative) {
+			break
+		}
+		p.operators.Pop()
+		p.emit(top)
+	}
+	operator := instruction{op: opBinary, token: token}
+	switch token.Text {
+	case "&&":
+		operator.target = len(p.program)
+		p.program = append(p.program, instruction{op: opJumpIfFalse, token: token})
+	case "||":
+		operator.target = len(p.program)
+		p.program = append(p.program, instruction{op: opJumpIfTrue, token: token})
+	}
+	p.operators.Push(operator)
+}
+
+// popUntilParen moves the operators above the innermost open parenthesis to the program and returns the parenthesis, which stays on the stack. It reports false when there is no open parenthesis.
+func (p *parser) popUntilParen() (instruction, bool) {
+	for {
+		top, err := p.operators.Peek()
+		if err != nil {
+			return instruction{}, false
+		}
+		if top.op == opParen {
+			return top, true
+		}
+		p.operators.Pop()
+		p.emit(top)
+	}
+}
+
+// emit appends an operator to the program. The jump of && and || is pointed past the operator, where the evaluation continues when the left operand decides the result.
+func (p *parser) emit(operator instruction) {
+	if operator.op == opBinary && (operator.token.Text == "&&" || operator.token.Text == "||") {
+		p.program[operator.target].target = len(p.program) + 1
+	}
+	p.program = append(p.program, operator)
+}
+
+func precedence(operator instruction) int {
+	if operator.op == opUnary {
+		return unaryPrecedence
+	}
+	return binaryPrecedence[operator.token.Text]
+}
+
+// Input returns the text the expression was parsed from.
+func (e *Expression) Input() string {
+	return e.input
+}
+
+// Variables returns the names of the variables the expression uses, in the order they first appear.
+func (e *Expression) Variables() []string {
+	names := []string{}
+	for _, instruction := range e.program {
+		if instruction.op == opVariable && !slices.Contains(names, instruction.token.Text) {
+			names = append(names, instruction.token.Text)
+		}
+	}
+	return names
+}
+
+// String returns the expression in reverse polish notation. Unary minus and plus are written as neg and pos, function calls as their name and number of arguments, like max/2.
+func (e *Expression) String() string {
+	words := []string{}
+	for _, instruction := range e.program {
+		switch {
+		case instruction.op == opJumpIfFalse || instruction.op == opJumpIfTrue:
+			continue
+		case instruction.op == opUnary && instruction.token.Text == "-":
+			words = append(words, "neg")
+		case instruction.op == opUnary && instruction.token.Text == "+":
+			words = append(words, "pos")
+		case instruction.op == opCall:
+			words = append(words, fmt.Sprintf("%s/%d", instruction.token.Text, instruction.arity))
+		default:
+			words = append(words, instruction.token.Text)
+		}
+	}
+	return strings.Join(words, " ")
+}
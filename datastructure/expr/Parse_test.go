@@ -0,0 +1,90 @@
+package expr
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input string
+		rpn   string
+	}{
+		{"1 + 2 * 3", "1 2 3 * +"},
+		{"(1 + 2) * 3", "1 2 + 3 *"},
+		{"10 - 4 - 3", "10 4 - 3 -"},
+		{"2 ^ 3 ^ 2", "2 3 2 ^ ^"},
+		{"-2 ^ 2", "2 2 ^ neg"},
+		{"2 ^ -1", "2 1 neg ^"},
+		{"2 * -x", "2 x neg *"},
+		{"+a - -b", "a pos b neg -"},
+		{"!a == b", "a ! b =="},
+		{"a < b == c >= d", "a b < c d >= =="},
+		{"a || b && c", "a b c && ||"},
+		{"max(1, min(a, b) + 1, 3)", "1 a b min/2 1 + 3 max/3"},
+		{"now()", "now/0"},
+		{"f((1), (2))", "1 2 f/2"},
+		{"((x))", "x"},
+	}
+	for _, test := range tests {
+		e, err := Parse(test.input)
+		if assert.Nil(t, err, test.input) {
+			assert.Equal(t, test.rpn, e.String(), test.input)
+			assert.Equal(t, test.input, e.Input())
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		input string
+		err   error
+		pos   int
+		text  string
+	}{
+		{"", ErrUnexpectedEnd, 0, ""},
+		{"1 +", ErrUnexpectedEnd, 3, ""},
+		{"f(1, 2", ErrUnbalancedParenthesis, 1, "("},
+		{"(1 + 2", ErrUnbalancedParenthesis, 0, "("},
+		{"1 + 2)", ErrUnbalancedParenthesis, 5, ")"},
+		{"1 2", ErrUnexpectedToken, 2, "2"},
+		{"a b", ErrUnexpectedToken, 2, "b"},
+		{"* 2", ErrUnexpectedToken, 0, "*"},
+		{"1 ! 2", ErrUnexpectedToken, 2, "!"},
+		{"()", ErrUnexpectedToken, 1, ")"},
+		{"f(1,)", ErrUnexpectedToken, 4, ")"},
+		{"f(,1)", ErrUnexpectedToken, 2, ","},
+		{"1, 2", ErrUnexpectedToken, 1, ","},
+		{"(1, 2)", ErrUnexpectedToken, 2, ","},
+		{"2 (3)", ErrUnexpectedToken, 2, "("},
+		{"true 1", ErrUnexpectedToken, 5, "1"},
+		{"1 + 1.2.3", ErrInvalidNumber, 4, "1.2.3"},
+	}
+	for _, test := range tests {
+		_, err := Parse(test.input)
+		assert.ErrorIs(t, err, test.err, test.input)
+		var positionErr *PositionError
+		if assert.True(t, errors.As(err, &positionErr), test.input) {
+			assert.Equal(t, test.pos, positionErr.Pos, test.input)
+			assert.Equal(t, test.text, positionErr.Text, test.input)
+		}
+	}
+
+	_, err := Parse("1 +")
+	assert.EqualError(t, err, "unexpected end of expression at offset 3")
+	_, err = Parse("1 2")
+	assert.EqualError(t, err, `unexpected token "2" at offset 2`)
+}
+
+func TestMustParse(t *testing.T) {
+	assert.Equal(t, "1 2 +", MustParse("1 + 2").String())
+	assert.Panics(t, func() { MustParse("1 +") })
+}
+
+func TestVariables(t *testing.T) {
+	e := MustParse("b * a + f(b, c) - true")
+	assert.Equal(t, []string{"b", "a", "c"}, e.Variables())
+	assert.Equal(t, []string{}, MustParse("1 + 2").Variables())
+}
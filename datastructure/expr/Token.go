@@ -0,0 +1,164 @@
+// Package expr parses and evaluates arithmetic and boolean expressions such as "2 * (x + 1) >= max(y, 3) && !done". The infix input is converted to reverse polish notation with the shunting-yard algorithm and the result is evaluated on a stack, both built on stack.Stack.
+//
+// Numbers are float64 values and booleans are bool values. The operators from the lowest to the highest precedence are:
+//
+//	||
+//	&&
+//	== !=
+//	< <= > >=
+//	+ -
+//	* / %
+//	unary - + !
+//	^ (power, right associative)
+//
+// && and || only evaluate their right operand when needed, so "x != 0 && 1 / x > 2" never divides by zero.
+package expr
+
+import (
+	"strconv"
+	"unicode"
+	"unicode/utf8"
+)
+
+// TokenKind is the kind of a Token.
+type TokenKind int
+
+const (
+	// Number is a number literal such as 3, 0.5 or 1e-3.
+	Number TokenKind = iota
+	// Bool is one of the literals true and false.
+	Bool
+	// Identifier is the name of a variable or, when followed by a parenthesis, of a function.
+	Identifier
+	// Operator is one of the operators listed in the package documentation.
+	Operator
+	// LeftParen is an opening parenthesis.
+	LeftParen
+	// RightParen is a closing parenthesis.
+	RightParen
+	// Comma separates the arguments of a function call.
+	Comma
+)
+
+var tokenKindNames = []string{"number", "bool", "identifier", "operator", "(", ")", ","}
+
+func (k TokenKind) String() string {
+	if k < 0 || int(k) >= len(tokenKindNames) {
+		return "TokenKind(" + strconv.Itoa(int(k)) + ")"
+	}
+	return tokenKindNames[k]
+}
+
+// Token is a piece of the input.
+//
+// Fields:
+//
+//	Kind: What the token is.
+//	Text: The token as written in the input.
+//	Pos: The byte offset of the token in the input.
+type Token struct {
+	Kind TokenKind
+	Text string
+	Pos  int
+}
+
+// operators lists every operator, the two character ones first so they win over their prefixes.
+var operators = []string{"&&", "||", "==", "!=", "<=", ">=", "+", "-", "*", "/", "%", "^", "<", ">", "!"}
+
+// Tokenize splits the input into tokens, skipping white space. It fails with ErrUnexpectedCharacter or ErrInvalidNumber at the offending position.
+//
+// Example:
+//
+//	tokens, _ := expr.Tokenize("max(a, 2) >= 1")
+//	// tokens: max ( a , 2 ) >= 1
+func Tokenize(input string) ([]Token, error) {
+	tokens := []Token{}
+	for pos := 0; pos < len(input); {
+		r, width := utf8.DecodeRuneInString(input[pos:])
+		switch {
+		case unicode.IsSpace(r):
+			pos += width
+		case isDigit(r) || r == '.':
+			end := scanNumber(input, pos)
+			token := Token{Kind: Number, Text: input[pos:end], Pos: pos}
+			if _, err := strconv.ParseFloat(token.Text, 64); err != nil {
+				return nil, errorAt(token, ErrInvalidNumber)
+			}
+			tokens = append(tokens, token)
+			pos = end
+		case unicode.IsLetter(r) || r == '_':
+			end := scanIdentifier(input, pos)
+			token := Token{Kind: Identifier, Text: input[pos:end], Pos: pos}
+			if token.Text == "true" || token.Text == "false" {
+				token.Kind = Bool
+			}
+			tokens = append(tokens, token)
+			pos = end
+		case r == '(':
+			tokens = append(tokens, Token{Kind: LeftParen, Text: "(", Pos: pos})
+			pos++
+		case r == ')':
+			tokens = append(tokens, Token{Kind: RightParen, Text: ")", Pos: pos})
+			pos++
+		case r == ',':
+			tokens = append(tokens, Token{Kind: Comma, Text: ",", Pos: pos})
+			pos++
+		default:
+			operator := matchOperator(input[pos:])
+			if operator == "" {
+				return nil, errorAt(Token{Text: string(r), Pos: pos}, ErrUnexpectedCharacter)
+			}
+			tokens = append(tokens, Token{Kind: Operator, Text: operator, Pos: pos})
+			pos += len(operator)
+		}
+	}
+	return tokens, nil
+}
+
+// scanNumber returns the end of the number starting at pos: digits and dots, then an optional exponent. Malformed numbers are scanned whole so they are reported as one token.
+func scanNumber(input string, pos int) int {
+	end := pos
+	for end < len(input) && (isDigit(rune(input[end])) || input[end] == '.') {
+		end++
+	}
+	if end < len(input) && (input[end] == 'e' || input[end] == 'E') {
+		exponent := end + 1
+		if exponent < len(input) && (input[exponent] == '+' || input[exponent] == '-') {
+			exponent++
+		}
+		if exponent < len(input) && isDigit(rune(input[exponent])) {
+			end = exponent
+			for end < len(input) && isDigit(rune(input[end])) {
+				end++
+			}
+		}
+	}
+	return end
+}
+
+// scanIdentifier returns the end of the identifier starting at pos.
+func scanIdentifier(input string, pos int) int {
+	end := pos
+	for end < len(input) {
+		r, width := utf8.DecodeRuneInString(input[end:])
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' {
+			break
+		}
+		end += width
+	}
+	return end
+}
+
+// matchOperator returns the operator the input starts with, or an empty string.
+func matchOperator(input string) string {
+	for _, operator := range operators {
+		if len(input) >= len(operator) && input[:len(operator)] == operator {
+			return operator
+		}
+	}
+	return ""
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
@@ -0,0 +1,78 @@
+package expr
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenize(t *testing.T) {
+	tokens, err := Tokenize("max(a_1, 2.5e-1) >= -.5 && !done || x%3==0")
+	assert.Nil(t, err)
+	expected := []Token{
+		{Identifier, "max", 0},
+		{LeftParen, "(", 3},
+		{Identifier, "a_1", 4},
+		{Comma, ",", 7},
+		{Number, "2.5e-1", 9},
+		{RightParen, ")", 15},
+		{Operator, ">=", 17},
+		{Operator, "-", 20},
+		{Number, ".5", 21},
+		{Operator, "&&", 24},
+		{Operator, "!", 27},
+		{Identifier, "done", 28},
+		{Operator, "||", 33},
+		{Identifier, "x", 36},
+		{Operator, "%", 37},
+		{Number, "3", 38},
+		{Operator, "==", 39},
+		{Number, "0", 41},
+	}
+	assert.Equal(t, expected, tokens)
+
+	tokens, err = Tokenize("  true false truthy 1E3 2e größe")
+	assert.Nil(t, err)
+	kinds := []TokenKind{}
+	for _, token := range tokens {
+		kinds = append(kinds, token.Kind)
+	}
+	assert.Equal(t, []TokenKind{Bool, Bool, Identifier, Number, Number, Identifier, Identifier}, kinds)
+	assert.Equal(t, "e", tokens[5].Text)
+	assert.Equal(t, "größe", tokens[6].Text)
+
+	tokens, err = Tokenize("")
+	assert.Nil(t, err)
+	assert.Equal(t, []Token{}, tokens)
+}
+
+func TestTokenizeErrors(t *testing.T) {
+	tests := []struct {
+		input string
+		err   error
+		pos   int
+		text  string
+	}{
+		{"1 + 1.2.3", ErrInvalidNumber, 4, "1.2.3"},
+		{"a = 1", ErrUnexpectedCharacter, 2, "="},
+		{"a & b", ErrUnexpectedCharacter, 2, "&"},
+		{"x + .", ErrInvalidNumber, 4, "."},
+		{"1 + €", ErrUnexpectedCharacter, 4, "€"},
+	}
+	for _, test := range tests {
+		_, err := Tokenize(test.input)
+		assert.ErrorIs(t, err, test.err, test.input)
+		var positionErr *PositionError
+		if assert.True(t, errors.As(err, &positionErr), test.input) {
+			assert.Equal(t, test.pos, positionErr.Pos, test.input)
+			assert.Equal(t, test.text, positionErr.Text, test.input)
+		}
+	}
+}
+
+func TestTokenKindString(t *testing.T) {
+	assert.Equal(t, "number", Number.String())
+	assert.Equal(t, ",", Comma.String())
+	assert.Equal(t, "TokenKind(42)", TokenKind(42).String())
+}
@@ -0,0 +1,288 @@
+// Package history keeps the undo and redo stacks of an application, like the edit history of a text editor. Every change is a Command that knows how to do and undo itself; the history runs the commands and moves them between the two stacks.
+package history
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/OmarFaruk-0x01/go_algorithms/datastructure/stack"
+)
+
+var (
+	// ErrNothingToUndo is returned by Undo when no change is left to undo.
+	ErrNothingToUndo = errors.New("nothing to undo")
+	// ErrNothingToRedo is returned by Redo when no undone change is left to redo.
+	ErrNothingToRedo = errors.New("nothing to redo")
+	// ErrInTransaction is returned by Begin, Undo, Redo and Clear while a transaction is open.
+	ErrInTransaction = errors.New("transaction in progress")
+	// ErrNoTransaction is returned by Commit and Rollback when no transaction is open.
+	ErrNoTransaction = errors.New("no transaction in progress")
+)
+
+// Command is a change that can be done and undone. Undo must revert exactly what Do changed, Do may be called again after Undo for redo.
+type Command interface {
+	Do() error
+	Undo() error
+}
+
+// funcCommand is a Command made of two functions.
+type funcCommand struct {
+	do   func() error
+	undo func() error
+}
+
+// NewCommand creates a Command from a function doing the change and one reverting it.
+func NewCommand(do func() error, undo func() error) Command {
+	return funcCommand{do: do, undo: undo}
+}
+
+func (c funcCommand) Do() error {
+	return c.do()
+}
+
+func (c funcCommand) Undo() error {
+	return c.undo()
+}
+
+// history runs commands and records them for undo and redo. Every entry of the stacks is a group of commands that is undone and redone as one: a single command, or every command of a transaction. It is not safe for concurrent use.
+//
+// Fields:
+//
+//	undo: The entries that can be undone, the latest on top.
+//	redo: The undone entries that can be redone, the latest undone on top.
+//	maxDepth: The maximum number of entries kept for undo, unlimited when 0 or less.
+//	pending: The commands done in the open transaction, in order.
+//	inTransaction: Whether a transaction is open.
+type history[C Command] struct {
+	undo          stack.Stack[[]C]
+	redo          stack.Stack[[]C]
+	maxDepth      int
+	pending       []C
+	inTransaction bool
+}
+
+// New creates an empty history keeping up to maxDepth entries for undo. When a new entry would go past maxDepth the oldest one is dropped and can no longer be undone. A maxDepth of 0 or less keeps every entry.
+//
+// Example:
+//
+//	edits := history.New[history.Command](100)
+//	edits.Do(history.NewCommand(
+//		func() error { text += "!"; return nil },
+//		func() error { text = text[:len(text)-1]; return nil },
+//	))
+//	edits.Undo()
+func New[C Command](maxDepth int) *history[C] {
+	return &history[C]{undo: stack.New[[]C](), redo: stack.New[[]C](), maxDepth: maxDepth}
+}
+
+// Do runs the command and records it for undo. Recording a new change clears the redo stack, since the undone changes may not apply on top of it. Inside a transaction the command joins the transaction instead. A command that fails is not recorded.
+func (h *history[C]) Do(command C) error {
+	if err := command.Do(); err != nil {
+		return err
+	}
+	if h.inTransaction {
+		h.pending = append(h.pending, command)
+		return nil
+	}
+	h.record([]C{command})
+	return nil
+}
+
+// Undo reverts the latest entry and moves it to the redo stack. The commands of a transaction are undone in reverse order. If one of them fails, the ones already undone are done again, the entry stays on the undo stack and the error is returned.
+func (h *history[C]) Undo() error {
+	if h.inTransaction {
+		return ErrInTransaction
+	}
+	entry, err := h.undo.Peek()
+	if err != nil {
+		return ErrNothingToUndo
+	}
+	if err := undoAll(entry); err != nil {
+		return err
+	}
+	h.undo.Pop()
+	h.redo.Push(entry)
+	return nil
+}
+
+// Redo does the latest undone entry again and moves it back to the undo stack. If one of its commands fails, the ones already done are undone, the entry stays on the redo stack and the error is returned.
+func (h *history[C]) Redo() error {
+	if h.inTransaction {
+		return ErrInTransaction
+	}
+	entry, err := h.redo.Peek()
+	if err != nil {
+		return ErrNothingToRedo
+	}
+	if err := doAll(entry); err != nil {
+		return err
+	}
+	h.redo.Pop()
+	h.undo.Push(entry)
+	return nil
+}
+
+func (h *history[C]) CanUndo() bool {
+	return !h.inTransaction && !h.undo.IsEmpty()
+}
+
+func (h *history[C]) CanRedo() bool {
+	return !h.inTransaction && !h.redo.IsEmpty()
+}
+
+// PeekUndo returns the commands Undo would revert, in the order they were done, for example to label an undo button.
+func (h *history[C]) PeekUndo() ([]C, error) {
+	entry, err := h.undo.Peek()
+	if err != nil {
+		return nil, ErrNothingToUndo
+	}
+	return slices.Clone(entry), nil
+}
+
+// PeekRedo returns the commands Redo would do again, in order.
+func (h *history[C]) PeekRedo() ([]C, error) {
+	entry, err := h.redo.Peek()
+	if err != nil {
+		return nil, ErrNothingToRedo
+	}
+	return slices.Clone(entry), nil
+}
+
+// UndoSize returns the number of entries that can be undone.
+func (h *history[C]) UndoSize() int {
+	return h.undo.Size()
+}
+
+// RedoSize returns the number of entries that can be redone.
+func (h *history[C]) RedoSize() int {
+	return h.redo.Size()
+}
+
+// Begin opens a transaction: the commands done until Commit are recorded as a single entry, so one Undo reverts all of them. Transactions do not nest.
+func (h *history[C]) Begin() error {
+	if h.inTransaction {
+		return ErrInTransaction
+	}
+	h.inTransaction = true
+	h.pending = nil
+	return nil
+}
+
+// Commit closes the transaction and records its commands as one entry. A transaction without commands records nothing and keeps the redo stack.
+func (h *history[C]) Commit() error {
+	if !h.inTransaction {
+		return ErrNoTransaction
+	}
+	pending := h.pending
+	h.inTransaction = false
+	h.pending = nil
+	if len(pending) > 0 {
+		h.record(pending)
+	}
+	return nil
+}
+
+// Rollback closes the transaction and undoes its commands in reverse order, nothing is recorded. Every command is undone even if some fail, the failures are returned joined.
+func (h *history[C]) Rollback() error {
+	if !h.inTransaction {
+		return ErrNoTransaction
+	}
+	pending := h.pending
+	h.inTransaction = false
+	h.pending = nil
+	var err error
+	for _, command := range slices.Backward(pending) {
+		err = errors.Join(err, command.Undo())
+	}
+	return err
+}
+
+// InTransaction reports whether a transaction is open.
+func (h *history[C]) InTransaction() bool {
+	return h.inTransaction
+}
+
+// Transaction runs the function inside a transaction. The transaction is committed when the function returns nil and rolled back otherwise, in which case the error of the function is returned together with any rollback failure.
+//
+// Example:
+//
+//	err := edits.Transaction(func() error {
+//		if err := edits.Do(deleteSelection); err != nil {
+//			return err
+//		}
+//		return edits.Do(insertText)
+//	})
+func (h *history[C]) Transaction(run func() error) error {
+	if err := h.Begin(); err != nil {
+		return err
+	}
+	if err := run(); err != nil {
+		return errors.Join(err, h.Rollback())
+	}
+	return h.Commit()
+}
+
+// MaxDepth returns the maximum number of entries kept for undo.
+func (h *history[C]) MaxDepth() int {
+	return h.maxDepth
+}
+
+// SetMaxDepth changes the maximum number of entries kept for undo, dropping the oldest ones right away when there are more.
+func (h *history[C]) SetMaxDepth(maxDepth int) {
+	h.maxDepth = maxDepth
+	h.evict()
+}
+
+// Clear forgets every entry of both stacks without running any command.
+func (h *history[C]) Clear() error {
+	if h.inTransaction {
+		return ErrInTransaction
+	}
+	h.undo = stack.New[[]C]()
+	h.redo = stack.New[[]C]()
+	return nil
+}
+
+func (h *history[C]) String() string {
+	return fmt.Sprintf("history(undo: %d, redo: %d)", h.undo.Size(), h.redo.Size())
+}
+
+// record pushes a new entry on the undo stack, clears the redo stack and drops the oldest entry when the max depth is exceeded.
+func (h *history[C]) record(entry []C) {
+	h.undo.Push(entry)
+	if !h.redo.IsEmpty() {
+		h.redo = stack.New[[]C]()
+	}
+	h.evict()
+}
+
+// evict drops the oldest entries of the undo stack until it fits the max depth. A stack only gives access to its top, so the kept entries are pushed onto a new stack, which takes O(maxDepth) time.
+func (h *history[C]) evict() {
+	if h.maxDepth <= 0 || h.undo.Size() <= h.maxDepth {
+		return
+	}
+	kept := slices.Collect(h.undo.Values())[:h.maxDepth]
+	slices.Reverse(kept)
+	h.undo = stack.FromSlice(kept)
+}
+
+// undoAll undoes the commands in reverse order. When one fails, the commands undone before it are done again so the entry is either fully undone or not at all.
+func undoAll[C Command](entry []C) error {
+	for i := len(entry) - 1; i >= 0; i-- {
+		if err := entry[i].Undo(); err != nil {
+			return errors.Join(err, doAll(entry[i+1:]))
+		}
+	}
+	return nil
+}
+
+// doAll does the commands in order. When one fails, the commands done before it are undone again.
+func doAll[C Command](entry []C) error {
+	for i, command := range entry {
+		if err := command.Do(); err != nil {
+			return errors.Join(err, undoAll(entry[:i]))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,280 @@
+package history
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// document is a text buffer changed by append and fail commands.
+type document struct {
+	text string
+}
+
+// appendText is a command adding text to the end of a document.
+type appendText struct {
+	document *document
+	text     string
+}
+
+func (c appendText) Do() error {
+	c.document.text += c.text
+	return nil
+}
+
+func (c appendText) Undo() error {
+	c.document.text = c.document.text[:len(c.document.text)-len(c.text)]
+	return nil
+}
+
+var errBroken = errors.New("broken")
+
+// broken is a command whose Do or Undo fails.
+type broken struct {
+	failDo   bool
+	failUndo bool
+}
+
+func (c *broken) Do() error {
+	if c.failDo {
+		return errBroken
+	}
+	return nil
+}
+
+func (c *broken) Undo() error {
+	if c.failUndo {
+		return errBroken
+	}
+	return nil
+}
+
+func TestDoUndoRedo(t *testing.T) {
+	doc := &document{}
+	h := New[appendText](0)
+	assert.ErrorIs(t, h.Undo(), ErrNothingToUndo)
+	assert.ErrorIs(t, h.Redo(), ErrNothingToRedo)
+	assert.False(t, h.CanUndo())
+
+	h.Do(appendText{doc, "Hello"})
+	h.Do(appendText{doc, ", "})
+	h.Do(appendText{doc, "World"})
+	assert.Equal(t, "Hello, World", doc.text)
+	assert.Equal(t, 3, h.UndoSize())
+
+	assert.Nil(t, h.Undo())
+	assert.Nil(t, h.Undo())
+	assert.Equal(t, "Hello", doc.text)
+	assert.True(t, h.CanRedo())
+	assert.Equal(t, 2, h.RedoSize())
+	next, err := h.PeekRedo()
+	assert.Nil(t, err)
+	assert.Equal(t, []appendText{{doc, ", "}}, next)
+
+	assert.Nil(t, h.Redo())
+	assert.Equal(t, "Hello, ", doc.text)
+	last, _ := h.PeekUndo()
+	assert.Equal(t, ", ", last[0].text)
+	assert.Equal(t, "history(undo: 2, redo: 1)", h.String())
+
+	// A new change makes the undone "World" impossible to redo.
+	h.Do(appendText{doc, "Go"})
+	assert.Equal(t, "Hello, Go", doc.text)
+	assert.False(t, h.CanRedo())
+	assert.ErrorIs(t, h.Redo(), ErrNothingToRedo)
+	_, err = h.PeekRedo()
+	assert.ErrorIs(t, err, ErrNothingToRedo)
+
+	for h.CanUndo() {
+		assert.Nil(t, h.Undo())
+	}
+	assert.Equal(t, "", doc.text)
+	_, err = h.PeekUndo()
+	assert.ErrorIs(t, err, ErrNothingToUndo)
+	for h.CanRedo() {
+		assert.Nil(t, h.Redo())
+	}
+	assert.Equal(t, "Hello, Go", doc.text)
+}
+
+func TestNewCommand(t *testing.T) {
+	count := 0
+	h := New[Command](0)
+	increment := NewCommand(
+		func() error { count++; return nil },
+		func() error { count--; return nil },
+	)
+	h.Do(increment)
+	h.Do(increment)
+	assert.Equal(t, 2, count)
+	h.Undo()
+	assert.Equal(t, 1, count)
+	h.Redo()
+	assert.Equal(t, 2, count)
+}
+
+func TestTransaction(t *testing.T) {
+	doc := &document{}
+	h := New[appendText](0)
+	h.Do(appendText{doc, "a"})
+	h.Undo()
+
+	assert.Nil(t, h.Begin())
+	assert.ErrorIs(t, h.Begin(), ErrInTransaction)
+	assert.True(t, h.InTransaction())
+	h.Do(appendText{doc, "b"})
+	h.Do(appendText{doc, "c"})
+	assert.False(t, h.CanUndo())
+	assert.ErrorIs(t, h.Undo(), ErrInTransaction)
+	assert.ErrorIs(t, h.Redo(), ErrInTransaction)
+	assert.ErrorIs(t, h.Clear(), ErrInTransaction)
+	assert.Nil(t, h.Commit())
+	assert.ErrorIs(t, h.Commit(), ErrNoTransaction)
+	assert.Equal(t, "bc", doc.text)
+	assert.Equal(t, 1, h.UndoSize())
+	assert.Equal(t, 0, h.RedoSize())
+
+	assert.Nil(t, h.Undo())
+	assert.Equal(t, "", doc.text)
+	assert.Nil(t, h.Redo())
+	assert.Equal(t, "bc", doc.text)
+
+	// An empty transaction records nothing and keeps what can be redone.
+	h.Undo()
+	assert.Nil(t, h.Transaction(func() error { return nil }))
+	assert.Equal(t, 0, h.UndoSize())
+	assert.Equal(t, 1, h.RedoSize())
+}
+
+func TestRollback(t *testing.T) {
+	doc := &document{text: "x"}
+	h := New[appendText](0)
+	assert.ErrorIs(t, h.Rollback(), ErrNoTransaction)
+
+	h.Begin()
+	h.Do(appendText{doc, "y"})
+	h.Do(appendText{doc, "z"})
+	assert.Nil(t, h.Rollback())
+	assert.Equal(t, "x", doc.text)
+	assert.False(t, h.InTransaction())
+	assert.Equal(t, 0, h.UndoSize())
+
+	err := h.Transaction(func() error {
+		h.Do(appendText{doc, "y"})
+		return errBroken
+	})
+	assert.ErrorIs(t, err, errBroken)
+	assert.Equal(t, "x", doc.text)
+	assert.Equal(t, 0, h.UndoSize())
+
+	err = h.Transaction(func() error {
+		return h.Do(appendText{doc, "y"})
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "xy", doc.text)
+	assert.Equal(t, 1, h.UndoSize())
+}
+
+func TestMaxDepth(t *testing.T) {
+	doc := &document{}
+	h := New[appendText](3)
+	assert.Equal(t, 3, h.MaxDepth())
+	for _, text := range []string{"a", "b", "c", "d", "e"} {
+		h.Do(appendText{doc, text})
+	}
+	assert.Equal(t, 3, h.UndoSize())
+	for h.CanUndo() {
+		h.Undo()
+	}
+	// "a" and "b" were evicted and can no longer be undone.
+	assert.Equal(t, "ab", doc.text)
+	assert.Equal(t, 3, h.RedoSize())
+
+	for h.CanRedo() {
+		h.Redo()
+	}
+	assert.Equal(t, "abcde", doc.text)
+	h.SetMaxDepth(1)
+	assert.Equal(t, 1, h.UndoSize())
+	last, _ := h.PeekUndo()
+	assert.Equal(t, "e", last[0].text)
+
+	h.SetMaxDepth(0)
+	for range 10 {
+		h.Do(appendText{doc, "."})
+	}
+	assert.Equal(t, 11, h.UndoSize())
+}
+
+func TestFailingCommands(t *testing.T) {
+	h := New[*broken](0)
+	failing := &broken{failDo: true}
+	assert.ErrorIs(t, h.Do(failing), errBroken)
+	assert.Equal(t, 0, h.UndoSize())
+
+	// The group stays on the undo stack when one of its commands can not be undone.
+	first, second := &broken{}, &broken{}
+	h.Transaction(func() error {
+		h.Do(first)
+		return h.Do(second)
+	})
+	first.failUndo = true
+	assert.ErrorIs(t, h.Undo(), errBroken)
+	assert.Equal(t, 1, h.UndoSize())
+	assert.Equal(t, 0, h.RedoSize())
+
+	first.failUndo = false
+	assert.Nil(t, h.Undo())
+	second.failDo = true
+	assert.ErrorIs(t, h.Redo(), errBroken)
+	assert.Equal(t, 0, h.UndoSize())
+	assert.Equal(t, 1, h.RedoSize())
+
+	// Rollback undoes every command and reports each failure.
+	h.Begin()
+	third := &broken{}
+	h.Do(third)
+	third.failUndo = true
+	assert.ErrorIs(t, h.Rollback(), errBroken)
+	assert.False(t, h.InTransaction())
+
+	assert.Nil(t, h.Clear())
+	assert.Equal(t, 0, h.RedoSize())
+}
+
+// counting counts how often each of its methods ran.
+type counting struct {
+	done   *int
+	undone *int
+	fail   bool
+}
+
+func (c counting) Do() error {
+	*c.done++
+	return nil
+}
+
+func (c counting) Undo() error {
+	if c.fail {
+		return errBroken
+	}
+	*c.undone++
+	return nil
+}
+
+func TestUndoFailureRestoresGroup(t *testing.T) {
+	done, undone := 0, 0
+	h := New[counting](0)
+	h.Transaction(func() error {
+		h.Do(counting{done: &done, undone: &undone, fail: true})
+		h.Do(counting{done: &done, undone: &undone})
+		return h.Do(counting{done: &done, undone: &undone})
+	})
+	assert.Equal(t, 3, done)
+
+	// The last two are undone, the first fails, so the last two are done again.
+	assert.ErrorIs(t, h.Undo(), errBroken)
+	assert.Equal(t, 2, undone)
+	assert.Equal(t, 5, done)
+}
@@ -0,0 +1,41 @@
+package durable
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+)
+
+// Codec converts the items of a disk-backed container to and from the bytes stored in its records.
+type Codec[T any] interface {
+	Marshal(item T) ([]byte, error)
+	Unmarshal(data []byte) (T, error)
+}
+
+// JSONCodec stores every item as JSON.
+type JSONCodec[T any] struct{}
+
+func (JSONCodec[T]) Marshal(item T) ([]byte, error) {
+	return json.Marshal(item)
+}
+
+func (JSONCodec[T]) Unmarshal(data []byte) (T, error) {
+	var item T
+	err := json.Unmarshal(data, &item)
+	return item, err
+}
+
+// GobCodec stores every item as a self-contained gob value. It handles the types JSON can not, like maps with struct keys, but repeats the type description in every record.
+type GobCodec[T any] struct{}
+
+func (GobCodec[T]) Marshal(item T) ([]byte, error) {
+	var buffer bytes.Buffer
+	err := gob.NewEncoder(&buffer).Encode(&item)
+	return buffer.Bytes(), err
+}
+
+func (GobCodec[T]) Unmarshal(data []byte) (T, error) {
+	var item T
+	err := gob.NewDecoder(bytes.NewReader(data)).Decode(&item)
+	return item, err
+}
@@ -0,0 +1,38 @@
+package durable
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type point struct {
+	X, Y int
+}
+
+func TestJSONCodec(t *testing.T) {
+	codec := JSONCodec[point]{}
+	data, err := codec.Marshal(point{1, 2})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"X":1,"Y":2}`, string(data))
+	decoded, err := codec.Unmarshal(data)
+	assert.Nil(t, err)
+	assert.Equal(t, point{1, 2}, decoded)
+
+	// JSON object keys have to be strings.
+	_, err = JSONCodec[map[point]string]{}.Marshal(map[point]string{{1, 2}: "a"})
+	assert.NotNil(t, err)
+}
+
+func TestGobCodec(t *testing.T) {
+	codec := GobCodec[map[point]string]{}
+	item := map[point]string{{1, 2}: "a", {3, 4}: "b"}
+	data, err := codec.Marshal(item)
+	assert.Nil(t, err)
+	decoded, err := codec.Unmarshal(data)
+	assert.Nil(t, err)
+	assert.Equal(t, item, decoded)
+
+	_, err = codec.Unmarshal(data[:len(data)/2])
+	assert.NotNil(t, err)
+}
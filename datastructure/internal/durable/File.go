@@ -0,0 +1,60 @@
+package durable
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// WriteFile replaces the file at path with data so that after a crash the file holds either the old or the new data, never a mix.
+func WriteFile(path string, data []byte) error {
+	return WriteFileWith(path, func(w io.Writer) error {
+		_, err := w.Write(data)
+		return err
+	})
+}
+
+// WriteFileWith replaces the file at path with what write writes, with the same guarantee as WriteFile: the data is written to a temporary file next to it, synced, renamed over the old file and the directory is synced. If any step before the rename fails the old file is kept and the temporary file is removed.
+func WriteFileWith(path string, write func(w io.Writer) error) error {
+	temporary := path + ".tmp"
+	file, err := os.OpenFile(temporary, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	renamed := false
+	defer func() {
+		if !renamed {
+			file.Close()
+			os.Remove(temporary)
+		}
+	}()
+	buffered := bufio.NewWriter(file)
+	if err := write(buffered); err != nil {
+		return err
+	}
+	if err := buffered.Flush(); err != nil {
+		return err
+	}
+	if err := file.Sync(); err != nil {
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(temporary, path); err != nil {
+		return err
+	}
+	renamed = true
+	return SyncDir(filepath.Dir(path))
+}
+
+// SyncDir syncs a directory, so files created, renamed or removed in it survive a crash.
+func SyncDir(dir string) error {
+	file, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return file.Sync()
+}
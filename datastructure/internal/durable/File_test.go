@@ -0,0 +1,54 @@
+package durable
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "checkpoint")
+	assert.Nil(t, WriteFile(path, []byte("first")))
+	assert.Nil(t, WriteFile(path, []byte("second")))
+
+	data, err := os.ReadFile(path)
+	assert.Nil(t, err)
+	assert.Equal(t, "second", string(data))
+	_, err = os.Stat(path + ".tmp")
+	assert.True(t, os.IsNotExist(err))
+
+	assert.NotNil(t, WriteFile(filepath.Join(t.TempDir(), "missing", "file"), nil))
+}
+
+func TestWriteFileWithKeepsOldFileOnError(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "snapshot")
+	assert.Nil(t, WriteFile(path, []byte("old")))
+
+	failure := errors.New("failed halfway")
+	err := WriteFileWith(path, func(w io.Writer) error {
+		w.Write([]byte("new"))
+		return failure
+	})
+	assert.ErrorIs(t, err, failure)
+	data, _ := os.ReadFile(path)
+	assert.Equal(t, "old", string(data))
+	_, err = os.Stat(path + ".tmp")
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestWriteFileRemovesTemporaryFileWhenRenameFails(t *testing.T) {
+	// A non-empty directory can not be replaced by a file.
+	path := filepath.Join(t.TempDir(), "snapshot")
+	assert.Nil(t, os.MkdirAll(filepath.Join(path, "inside"), 0o755))
+
+	assert.NotNil(t, WriteFile(path, []byte("new")))
+	_, err := os.Stat(path + ".tmp")
+	assert.True(t, os.IsNotExist(err))
+	info, err := os.Stat(path)
+	assert.Nil(t, err)
+	assert.True(t, info.IsDir())
+}
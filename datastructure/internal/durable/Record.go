@@ -0,0 +1,108 @@
+// Package durable holds the building blocks the disk-backed containers share: length-prefixed, checksummed records that can be appended to a log and read back, with torn and corrupt records told apart from a clean end, and files replaced atomically.
+package durable
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"io"
+)
+
+var (
+	// ErrTorn is returned when a record is cut short, which happens when a crash interrupts an append.
+	ErrTorn = errors.New("torn record")
+	// ErrCorrupt is returned when a record does not match its checksum or announces an impossible length.
+	ErrCorrupt = errors.New("corrupt record")
+	// ErrTooLarge is returned by AppendRecord for a payload longer than MaxPayloadSize. Nothing is written.
+	ErrTooLarge = errors.New("record too large")
+)
+
+const (
+	// HeaderSize is the number of bytes written before every payload: the payload length and its CRC-32C, both little endian.
+	HeaderSize = 8
+	// MaxPayloadSize bounds the length a record may announce, so a corrupt length is not mistaken for a huge record.
+	MaxPayloadSize = 64 << 20
+)
+
+var castagnoli = crc32.MakeTable(crc32.Castagnoli)
+
+// RecordSize returns the number of bytes a record with the given payload takes in the log.
+func RecordSize(payload []byte) int64 {
+	return int64(HeaderSize + len(payload))
+}
+
+// AppendRecord writes one record with a single call to w, so a crash tears at most this record.
+func AppendRecord(w io.Writer, payload []byte) error {
+	if len(payload) > MaxPayloadSize {
+		return ErrTooLarge
+	}
+	record := make([]byte, HeaderSize+len(payload))
+	binary.LittleEndian.PutUint32(record[0:4], uint32(len(payload)))
+	binary.LittleEndian.PutUint32(record[4:8], crc32.Checksum(payload, castagnoli))
+	copy(record[HeaderSize:], payload)
+	_, err := w.Write(record)
+	return err
+}
+
+// Reader reads the records AppendRecord wrote one by one.
+//
+// Fields:
+//
+//	r: The log being read.
+//	offset: The number of bytes of complete, valid records read so far.
+//	atTail: Whether the record that made Next fail reaches the end of the log.
+type Reader struct {
+	r      io.Reader
+	offset int64
+	atTail bool
+}
+
+// NewReader creates a reader for the records of r.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{r: r}
+}
+
+// Next returns the payload of the next record. It returns io.EOF when the log ends cleanly after the previous record, ErrTorn when the log ends inside a record and ErrCorrupt when the record does not check out. After an error, Offset is where the valid part of the log ends and AtTail tells whether the failed record was the last thing in the log.
+func (r *Reader) Next() ([]byte, error) {
+	var header [HeaderSize]byte
+	if _, err := io.ReadFull(r.r, header[:]); err != nil {
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			r.atTail = true
+			return nil, ErrTorn
+		}
+		return nil, err
+	}
+	length := binary.LittleEndian.Uint32(header[0:4])
+	if length > MaxPayloadSize {
+		// The record reaches the end of the log when fewer bytes than it announces are left.
+		left, _ := io.Copy(io.Discard, io.LimitReader(r.r, int64(length)))
+		r.atTail = left < int64(length)
+		return nil, ErrCorrupt
+	}
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(r.r, payload); err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			r.atTail = true
+			return nil, ErrTorn
+		}
+		return nil, err
+	}
+	if crc32.Checksum(payload, castagnoli) != binary.LittleEndian.Uint32(header[4:8]) {
+		var next [1]byte
+		n, _ := io.ReadFull(r.r, next[:])
+		r.atTail = n == 0
+		return nil, ErrCorrupt
+	}
+	r.offset += HeaderSize + int64(length)
+	return payload, nil
+}
+
+// AtTail reports whether the record that made Next fail with ErrTorn or ErrCorrupt is the last thing in the log. A crash during an append can only damage the last record, so damage at the tail can be cut off, while damage followed by more records means the log itself is broken.
+func (r *Reader) AtTail() bool {
+	return r.atTail
+}
+
+// Offset returns the number of bytes of the valid records read so far.
+func (r *Reader) Offset() int64 {
+	return r.offset
+}
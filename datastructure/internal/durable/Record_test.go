@@ -0,0 +1,92 @@
+package durable
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writeRecords(payloads ...string) []byte {
+	var buffer bytes.Buffer
+	for _, payload := range payloads {
+		AppendRecord(&buffer, []byte(payload))
+	}
+	return buffer.Bytes()
+}
+
+func TestAppendAndRead(t *testing.T) {
+	data := writeRecords("one", "", "three")
+	assert.Equal(t, RecordSize([]byte("one"))+RecordSize(nil)+RecordSize([]byte("three")), int64(len(data)))
+
+	reader := NewReader(bytes.NewReader(data))
+	for _, want := range []string{"one", "", "three"} {
+		payload, err := reader.Next()
+		assert.Nil(t, err)
+		assert.Equal(t, want, string(payload))
+	}
+	_, err := reader.Next()
+	assert.ErrorIs(t, err, io.EOF)
+	assert.Equal(t, int64(len(data)), reader.Offset())
+}
+
+func TestTornRecord(t *testing.T) {
+	data := writeRecords("one", "two")
+	valid := RecordSize([]byte("one"))
+	// Cut inside the header and inside the payload of the second record.
+	for _, cut := range []int64{valid + 3, valid + HeaderSize + 1} {
+		reader := NewReader(bytes.NewReader(data[:cut]))
+		_, err := reader.Next()
+		assert.Nil(t, err)
+		_, err = reader.Next()
+		assert.ErrorIs(t, err, ErrTorn)
+		assert.True(t, reader.AtTail())
+		assert.Equal(t, valid, reader.Offset())
+	}
+}
+
+func TestCorruptRecord(t *testing.T) {
+	data := writeRecords("one", "two")
+	data[len(data)-1] ^= 0xff
+	reader := NewReader(bytes.NewReader(data))
+	reader.Next()
+	_, err := reader.Next()
+	assert.ErrorIs(t, err, ErrCorrupt)
+	assert.True(t, reader.AtTail())
+	assert.Equal(t, RecordSize([]byte("one")), reader.Offset())
+
+	huge := writeRecords("x")
+	huge[3] = 0xff
+	reader = NewReader(bytes.NewReader(huge))
+	_, err = reader.Next()
+	assert.ErrorIs(t, err, ErrCorrupt)
+	assert.True(t, reader.AtTail())
+}
+
+func TestCorruptRecordBeforeTail(t *testing.T) {
+	data := writeRecords("one", "two")
+	data[HeaderSize] ^= 0xff
+	reader := NewReader(bytes.NewReader(data))
+	_, err := reader.Next()
+	assert.ErrorIs(t, err, ErrCorrupt)
+	assert.False(t, reader.AtTail())
+	assert.Equal(t, int64(0), reader.Offset())
+
+	// A damaged length that still fits inside the log is not mistaken for a torn tail either.
+	data = writeRecords("one", "two", strings.Repeat("x", 100))
+	data[0] = 20
+	reader = NewReader(bytes.NewReader(data))
+	_, err = reader.Next()
+	assert.ErrorIs(t, err, ErrCorrupt)
+	assert.False(t, reader.AtTail())
+}
+
+func TestAppendTooLarge(t *testing.T) {
+	var buffer bytes.Buffer
+	err := AppendRecord(&buffer, make([]byte, MaxPayloadSize+1))
+	assert.ErrorIs(t, err, ErrTooLarge)
+	assert.NotErrorIs(t, err, ErrCorrupt)
+	assert.Equal(t, 0, buffer.Len())
+}
@@ -0,0 +1,70 @@
+// Package gobstream writes containers as a gob encoded header followed by their items one gob value at a time, so a container can be saved and loaded without copying all of its items into a slice first. Gob sends the type of the items only once per stream, which keeps the format compact.
+package gobstream
+
+import (
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"reflect"
+)
+
+// ErrNilItem is returned by Encode for an item that is a nil pointer. Gob writes nothing for it, so the stream would silently lose the item and fail to decode.
+var ErrNilItem = errors.New("gobstream: nil pointer items are not supported")
+
+// Header is written before the items.
+//
+// Fields:
+//
+//	Size: The number of items that follow the header.
+//	Max: The max of bounded containers, 0 for the others.
+type Header struct {
+	Size int
+	Max  int
+}
+
+// Encode writes the header and then every item of the sequence. The sequence must yield exactly header.Size items, none of which may be a nil pointer. Encode stops with ErrNilItem at the first nil pointer, after writing the items before it.
+func Encode[T any](w io.Writer, header Header, items iter.Seq[T]) error {
+	encoder := gob.NewEncoder(w)
+	if err := encoder.Encode(header); err != nil {
+		return err
+	}
+	written := 0
+	for item := range items {
+		if value := reflect.ValueOf(&item).Elem(); value.Kind() == reflect.Pointer && value.IsNil() {
+			return fmt.Errorf("%w: item %d", ErrNilItem, written)
+		}
+		if err := encoder.Encode(&item); err != nil {
+			return err
+		}
+		written++
+	}
+	if written != header.Size {
+		return fmt.Errorf("gobstream: header announced %d items but %d were written", header.Size, written)
+	}
+	return nil
+}
+
+// Decode reads a header and hands the items that follow it to add in the order they were written. It returns io.ErrUnexpectedEOF if the stream ends before the announced number of items. Unless r implements io.ByteReader, Decode may read past the end of the encoded container.
+func Decode[T any](r io.Reader, add func(item T)) (Header, error) {
+	decoder := gob.NewDecoder(r)
+	var header Header
+	if err := decoder.Decode(&header); err != nil {
+		return header, err
+	}
+	if header.Size < 0 {
+		return header, fmt.Errorf("gobstream: invalid item count %d", header.Size)
+	}
+	for i := 0; i < header.Size; i++ {
+		var item T
+		if err := decoder.Decode(&item); err != nil {
+			if errors.Is(err, io.EOF) {
+				err = io.ErrUnexpectedEOF
+			}
+			return header, err
+		}
+		add(item)
+	}
+	return header, nil
+}
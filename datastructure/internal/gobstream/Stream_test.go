@@ -0,0 +1,72 @@
+package gobstream
+
+import (
+	"bytes"
+	"io"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type point struct {
+	X, Y int
+}
+
+func TestEncodeDecode(t *testing.T) {
+	var buffer bytes.Buffer
+	items := []point{{1, 2}, {3, 4}, {0, 0}}
+	assert.Nil(t, Encode(&buffer, Header{Size: 3, Max: 10}, slices.Values(items)))
+
+	var decoded []point
+	header, err := Decode(&buffer, func(item point) { decoded = append(decoded, item) })
+	assert.Nil(t, err)
+	assert.Equal(t, Header{Size: 3, Max: 10}, header)
+	assert.Equal(t, items, decoded)
+}
+
+func TestEncodeChecksSize(t *testing.T) {
+	var buffer bytes.Buffer
+	assert.NotNil(t, Encode(&buffer, Header{Size: 1}, slices.Values([]int{1, 2})))
+}
+
+func TestEncodeNilItem(t *testing.T) {
+	var buffer bytes.Buffer
+	one := 1
+	err := Encode(&buffer, Header{Size: 3}, slices.Values([]*int{&one, nil, &one}))
+	assert.ErrorIs(t, err, ErrNilItem)
+	assert.ErrorContains(t, err, "item 1")
+
+	// Nil values that are not pointers are fine.
+	buffer.Reset()
+	assert.Nil(t, Encode(&buffer, Header{Size: 2}, slices.Values([]any{nil, 1})))
+	var decoded []any
+	_, err = Decode(&buffer, func(item any) { decoded = append(decoded, item) })
+	assert.Nil(t, err)
+	assert.Equal(t, []any{nil, 1}, decoded)
+}
+
+func TestDecodeTruncated(t *testing.T) {
+	var buffer bytes.Buffer
+	Encode(&buffer, Header{Size: 3}, slices.Values([]string{"a", "b", "c"}))
+	data := buffer.Bytes()
+
+	var decoded []string
+	_, err := Decode(bytes.NewReader(data[:len(data)-4]), func(item string) { decoded = append(decoded, item) })
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+
+	_, err = Decode(bytes.NewReader(nil), func(item string) {})
+	assert.ErrorIs(t, err, io.EOF)
+
+	buffer.Reset()
+	Encode(&buffer, Header{Size: -1}, slices.Values([]string{}))
+	_, err = Decode(&buffer, func(item string) {})
+	assert.NotNil(t, err)
+}
+
+func TestDecodeWrongType(t *testing.T) {
+	var buffer bytes.Buffer
+	Encode(&buffer, Header{Size: 1}, slices.Values([]string{"a"}))
+	_, err := Decode(&buffer, func(item int) {})
+	assert.NotNil(t, err)
+}
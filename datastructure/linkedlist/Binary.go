@@ -0,0 +1,99 @@
+package linkedlist
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/OmarFaruk-0x01/go_algorithms/datastructure/internal/gobstream"
+)
+
+// Encode writes the linked list to w in a compact binary form: a gob header with the size followed by the items one by one from the first to the last, so no copy of the list is built in memory. Gob can not encode nil pointers, so a list holding one fails to encode.
+//
+// Complexity:
+//
+//	Time - O(n)
+//	Space - O(1)
+//
+// Example:
+//
+//	file, _ := os.Create("list.bin")
+//	defer file.Close()
+//	err := myList.Encode(file)
+func (l linkedList[T]) Encode(w io.Writer) error {
+	return gobstream.Encode(w, gobstream.Header{Size: l.size}, l.Values())
+}
+
+// Decode replaces the items of the linked list with the items Encode wrote to r. The list is only changed if the whole list could be read. Unless r implements io.ByteReader, Decode may read past the end of the encoded list.
+//
+// Complexity:
+//
+//	Time - O(n)
+//	Space - O(n)
+//
+// Example:
+//
+//	file, _ := os.Open("list.bin")
+//	defer file.Close()
+//	myList := linkedlist.New[int]()
+//	err := myList.Decode(bufio.NewReader(file))
+func (l *linkedList[T]) Decode(r io.Reader) error {
+	decoded := New[T]()
+	if _, err := gobstream.Decode(r, decoded.AddLast); err != nil {
+		return err
+	}
+	*l = decoded
+	return nil
+}
+
+// MarshalBinary encodes the linked list in the form Encode writes. It also makes the list usable with encoding/gob.
+func (l linkedList[T]) MarshalBinary() ([]byte, error) {
+	var buffer bytes.Buffer
+	if err := l.Encode(&buffer); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// UnmarshalBinary replaces the items of the linked list with the items MarshalBinary encoded.
+func (l *linkedList[T]) UnmarshalBinary(data []byte) error {
+	return l.Decode(bytes.NewReader(data))
+}
+
+// Encode writes the doubly linked list to w in the same form as the singly linked list, so either list can decode what the other one encoded.
+//
+// Complexity:
+//
+//	Time - O(n)
+//	Space - O(1)
+func (l doublyLinkedList[T]) Encode(w io.Writer) error {
+	return gobstream.Encode(w, gobstream.Header{Size: l.size}, l.Values())
+}
+
+// Decode replaces the items of the doubly linked list with the items Encode wrote to r. The list is only changed if the whole list could be read.
+//
+// Complexity:
+//
+//	Time - O(n)
+//	Space - O(n)
+func (l *doublyLinkedList[T]) Decode(r io.Reader) error {
+	decoded := NewDoubly[T]()
+	if _, err := gobstream.Decode(r, decoded.AddLast); err != nil {
+		return err
+	}
+	*l = decoded
+	return nil
+}
+
+// MarshalBinary encodes the doubly linked list in the form Encode writes. It also makes the list usable with encoding/gob.
+func (l doublyLinkedList[T]) MarshalBinary() ([]byte, error) {
+	var buffer bytes.Buffer
+	if err := l.Encode(&buffer); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// UnmarshalBinary replaces the items of the doubly linked list with the items MarshalBinary encoded.
+func (l *doublyLinkedList[T]) UnmarshalBinary(data []byte) error {
+	return l.Decode(bytes.NewReader(data))
+}
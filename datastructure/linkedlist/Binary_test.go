@@ -0,0 +1,99 @@
+package linkedlist
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	_ encoding.BinaryMarshaler   = linkedList[int]{}
+	_ encoding.BinaryUnmarshaler = (*linkedList[int])(nil)
+	_ encoding.BinaryMarshaler   = doublyLinkedList[int]{}
+	_ encoding.BinaryUnmarshaler = (*doublyLinkedList[int])(nil)
+)
+
+func TestEncodeDecode(t *testing.T) {
+	forEachImplementation(t, func(t *testing.T, implementation string) {
+		var buffer bytes.Buffer
+		original := Of(member{"Omar Faruk", 20}, member{"Tanvir Raj", 25})
+		assert.Nil(t, original.Encode(&buffer))
+
+		decoded := newList[member](implementation)
+		decoded.AddLast(member{"old", 1})
+		assert.Nil(t, decoded.(interface{ Decode(io.Reader) error }).Decode(&buffer))
+		assert.Equal(t, original.ToSlice(), decoded.ToSlice())
+		last, _ := decoded.Last()
+		assert.Equal(t, member{"Tanvir Raj", 25}, last)
+	})
+}
+
+func TestEncodeDecodeLarge(t *testing.T) {
+	list := New[int]()
+	for i := 0; i < 100000; i++ {
+		list.AddLast(i)
+	}
+	var buffer bytes.Buffer
+	assert.Nil(t, list.Encode(&buffer))
+
+	decoded := NewDoubly[int]()
+	assert.Nil(t, decoded.Decode(&buffer))
+	assert.Equal(t, 100000, decoded.Size())
+	assert.True(t, list.EqualFunc(&decoded, func(a, b int) bool { return a == b }))
+}
+
+func TestDecodeKeepsListOnError(t *testing.T) {
+	var buffer bytes.Buffer
+	Of(1, 2, 3).Encode(&buffer)
+	truncated := buffer.Bytes()[:buffer.Len()-2]
+
+	list := Of(7)
+	assert.ErrorIs(t, list.Decode(bytes.NewReader(truncated)), io.ErrUnexpectedEOF)
+	assert.Equal(t, []int{7}, list.ToSlice())
+
+	doubly := DoublyOf(7)
+	assert.NotNil(t, doubly.UnmarshalBinary(truncated))
+	assert.Equal(t, []int{7}, doubly.ToSlice())
+}
+
+func TestEncodeNilPointer(t *testing.T) {
+	one := 1
+	var buffer bytes.Buffer
+	assert.NotNil(t, Of(&one, nil).Encode(&buffer))
+	_, err := DoublyOf[*int](nil).MarshalBinary()
+	assert.NotNil(t, err)
+}
+
+func TestBinaryMarshaler(t *testing.T) {
+	data, err := DoublyOf("a", "b", "c").MarshalBinary()
+	assert.Nil(t, err)
+	list := New[string]()
+	assert.Nil(t, list.UnmarshalBinary(data))
+	assert.Equal(t, []string{"a", "b", "c"}, list.ToSlice())
+
+	empty, err := New[string]().MarshalBinary()
+	assert.Nil(t, err)
+	assert.Nil(t, list.UnmarshalBinary(empty))
+	assert.Equal(t, 0, list.Size())
+}
+
+func TestGob(t *testing.T) {
+	type team struct {
+		Name    string
+		Members linkedList[member]
+		Backlog doublyLinkedList[int]
+	}
+	original := team{Name: "core", Members: Of(member{"a", 1}, member{"b", 2}), Backlog: DoublyOf(3, 2, 1)}
+
+	var buffer bytes.Buffer
+	assert.Nil(t, gob.NewEncoder(&buffer).Encode(original))
+	var decoded team
+	assert.Nil(t, gob.NewDecoder(&buffer).Decode(&decoded))
+	assert.Equal(t, "core", decoded.Name)
+	assert.Equal(t, original.Members.ToSlice(), decoded.Members.ToSlice())
+	assert.Equal(t, []int{1, 2, 3}, collectBackward(decoded.Backlog))
+}
@@ -0,0 +1,24 @@
+package linkedlist_test
+
+import (
+	"testing"
+
+	"github.com/OmarFaruk-0x01/go_algorithms/datastructure/containertest"
+	"github.com/OmarFaruk-0x01/go_algorithms/datastructure/linkedlist"
+)
+
+// TestConformance runs the shared linked list suite against both lists of the package. It lives in the external test package because containertest imports linkedlist.
+func TestConformance(t *testing.T) {
+	t.Run("singly", func(t *testing.T) {
+		containertest.RunLinkedListSuite(t, func() linkedlist.LinkedList[int] {
+			list := linkedlist.New[int]()
+			return &list
+		})
+	})
+	t.Run("doubly", func(t *testing.T) {
+		containertest.RunLinkedListSuite(t, func() linkedlist.LinkedList[int] {
+			list := linkedlist.NewDoubly[int]()
+			return &list
+		})
+	})
+}
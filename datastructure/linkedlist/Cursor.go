@@ -0,0 +1,292 @@
+package linkedlist
+
+import "errors"
+
+// ErrNoCurrentItem is returned when a Cursor is asked for the item it sits on while it sits on a gap.
+var ErrNoCurrentItem = errors.New("cursor is not positioned on an item")
+
+// Cursor is a stateful position inside a LinkedList. It sits on a gap between two elements, or on an element after a successful call to Next, so a single pass can read, insert and delete items without walking the list again.
+//
+// A fresh cursor sits before the first element. Modifying the list through anything other than the cursor invalidates it until Reset is called.
+//
+// Example:
+//
+//	myList := linkedlist.New[int]()
+//	myList.AddLast(1)
+//	myList.AddLast(2)
+//	myList.AddLast(3)
+//	cursor := myList.Cursor()
+//	for cursor.Next() {
+//		value, _ := cursor.Value()
+//		if value%2 == 0 {
+//			cursor.Remove()
+//		}
+//	}
+//	myList.ToSlice() // [1 3]
+type Cursor[T any] interface {
+	Next() bool
+	Value() (T, error)
+	InsertBefore(item T)
+	InsertAfter(item T)
+	Remove() error
+	Reset()
+}
+
+// CursorList is a LinkedList that hands out a Cursor. Both lists of this package implement it. It is kept out of LinkedList so that implementations outside of this package do not have to provide a cursor.
+type CursorList[T any] interface {
+	LinkedList[T]
+	Cursor() Cursor[T]
+}
+
+// cursor walks a singly linked list. Besides the current node it remembers its predecessor so that the current node can be unlinked in O(1).
+//
+// Fields:
+//
+//	list: The linked list the cursor walks.
+//	prev: The node before the cursor, nil when the cursor is at the beginning.
+//	current: The node the cursor sits on, nil when the cursor sits on a gap.
+//	next: The node Next moves to.
+type cursor[T any] struct {
+	list    *linkedList[T]
+	prev    *node[T]
+	current *node[T]
+	next    *node[T]
+}
+
+// Cursor creates a new Cursor positioned before the first element of the linked list.
+//
+// Complexity:
+//
+//	Time - O(1)
+func (l *linkedList[T]) Cursor() Cursor[T] {
+	return &cursor[T]{list: l, next: l.first}
+}
+
+// Next moves the cursor to the following element. It returns false once the cursor moved past the last element.
+//
+// Complexity:
+//
+//	Time - O(1)
+func (c *cursor[T]) Next() bool {
+	if c.current != nil {
+		c.prev = c.current
+	}
+	c.current = c.next
+	if c.current == nil {
+		return false
+	}
+	c.next = c.current.next
+	return true
+}
+
+// Value returns the element the cursor sits on. If the cursor is not on an element it will return a error.
+//
+// Complexity:
+//
+//	Time - O(1)
+func (c *cursor[T]) Value() (T, error) {
+	if c.current == nil {
+		return c.list._nil, ErrNoCurrentItem
+	}
+	return c.current.value, nil
+}
+
+// InsertBefore adds an element right before the element the cursor sits on, or into the gap the cursor sits on. The new element is behind the cursor and will not be visited by Next.
+//
+// Complexity:
+//
+//	Time - O(1)
+//	Space - O(1)
+func (c *cursor[T]) InsertBefore(item T) {
+	after := c.current
+	if after == nil {
+		after = c.next
+	}
+	c.prev = c.link(item, c.prev, after)
+}
+
+// InsertAfter adds an element right after the element the cursor sits on, or into the gap the cursor sits on. The new element is ahead of the cursor and will be visited by the next call to Next.
+//
+// Complexity:
+//
+//	Time - O(1)
+//	Space - O(1)
+func (c *cursor[T]) InsertAfter(item T) {
+	before := c.current
+	if before == nil {
+		before = c.prev
+	}
+	c.next = c.link(item, before, c.next)
+}
+
+// Remove removes the element the cursor sits on. The cursor is left on the gap the element used to fill, so the following call to Next moves to its successor. If the cursor is not on an element it will return a error.
+//
+// Complexity:
+//
+//	Time - O(1)
+func (c *cursor[T]) Remove() error {
+	if c.current == nil {
+		return ErrNoCurrentItem
+	}
+	if c.prev == nil {
+		c.list.first = c.next
+	} else {
+		c.prev.next = c.next
+	}
+	if c.next == nil {
+		c.list.last = c.prev
+	}
+	c.current.next = nil
+	c.current = nil
+	c.list.size--
+	return nil
+}
+
+// Reset moves the cursor back before the first element of the linked list.
+//
+// Complexity:
+//
+//	Time - O(1)
+func (c *cursor[T]) Reset() {
+	c.prev = nil
+	c.current = nil
+	c.next = c.list.first
+}
+
+// link creates a node for the item between the two given nodes and returns it. A nil before or after means the new node becomes the first or the last node of the list.
+func (c *cursor[T]) link(item T, before *node[T], after *node[T]) *node[T] {
+	newNode := &node[T]{value: item, next: after}
+	if before == nil {
+		c.list.first = newNode
+	} else {
+		before.next = newNode
+	}
+	if after == nil {
+		c.list.last = newNode
+	}
+	c.list.size++
+	return newNode
+}
+
+// doublyCursor walks a doubly linked list. The nodes already know their neighbours, the cursor only remembers the gap it sits on when the current node has been removed.
+//
+// Fields:
+//
+//	list: The linked list the cursor walks.
+//	prev: The node before the cursor, nil when the cursor is at the beginning.
+//	current: The node the cursor sits on, nil when the cursor sits on a gap.
+//	next: The node Next moves to.
+type doublyCursor[T any] struct {
+	list    *doublyLinkedList[T]
+	prev    *doublyNode[T]
+	current *doublyNode[T]
+	next    *doublyNode[T]
+}
+
+// Cursor creates a new Cursor positioned before the first element of the linked list.
+//
+// Complexity:
+//
+//	Time - O(1)
+func (l *doublyLinkedList[T]) Cursor() Cursor[T] {
+	return &doublyCursor[T]{list: l, next: l.first}
+}
+
+// Next moves the cursor to the following element. It returns false once the cursor moved past the last element.
+//
+// Complexity:
+//
+//	Time - O(1)
+func (c *doublyCursor[T]) Next() bool {
+	if c.current != nil {
+		c.prev = c.current
+	}
+	c.current = c.next
+	if c.current == nil {
+		return false
+	}
+	c.next = c.current.next
+	return true
+}
+
+// Value returns the element the cursor sits on. If the cursor is not on an element it will return a error.
+//
+// Complexity:
+//
+//	Time - O(1)
+func (c *doublyCursor[T]) Value() (T, error) {
+	if c.current == nil {
+		return c.list._nil, ErrNoCurrentItem
+	}
+	return c.current.value, nil
+}
+
+// InsertBefore adds an element right before the element the cursor sits on, or into the gap the cursor sits on. The new element is behind the cursor and will not be visited by Next.
+//
+// Complexity:
+//
+//	Time - O(1)
+//	Space - O(1)
+func (c *doublyCursor[T]) InsertBefore(item T) {
+	after := c.current
+	if after == nil {
+		after = c.next
+	}
+	c.prev = c.link(item, c.prev, after)
+}
+
+// InsertAfter adds an element right after the element the cursor sits on, or into the gap the cursor sits on. The new element is ahead of the cursor and will be visited by the next call to Next.
+//
+// Complexity:
+//
+//	Time - O(1)
+//	Space - O(1)
+func (c *doublyCursor[T]) InsertAfter(item T) {
+	before := c.current
+	if before == nil {
+		before = c.prev
+	}
+	c.next = c.link(item, before, c.next)
+}
+
+// Remove removes the element the cursor sits on. The cursor is left on the gap the element used to fill, so the following call to Next moves to its successor. If the cursor is not on an element it will return a error.
+//
+// Complexity:
+//
+//	Time - O(1)
+func (c *doublyCursor[T]) Remove() error {
+	if c.current == nil {
+		return ErrNoCurrentItem
+	}
+	c.list.unlink(c.current)
+	c.current = nil
+	return nil
+}
+
+// Reset moves the cursor back before the first element of the linked list.
+//
+// Complexity:
+//
+//	Time - O(1)
+func (c *doublyCursor[T]) Reset() {
+	c.prev = nil
+	c.current = nil
+	c.next = c.list.first
+}
+
+// link creates a node for the item between the two given nodes and returns it. A nil before or after means the new node becomes the first or the last node of the list.
+func (c *doublyCursor[T]) link(item T, before *doublyNode[T], after *doublyNode[T]) *doublyNode[T] {
+	newNode := &doublyNode[T]{value: item, prev: before, next: after}
+	if before == nil {
+		c.list.first = newNode
+	} else {
+		before.next = newNode
+	}
+	if after == nil {
+		c.list.last = newNode
+	} else {
+		after.prev = newNode
+	}
+	c.list.size++
+	return newNode
+}
@@ -0,0 +1,113 @@
+package linkedlist
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	_ CursorList[int] = (*linkedList[int])(nil)
+	_ CursorList[int] = (*doublyLinkedList[int])(nil)
+)
+
+func TestCursorNext(t *testing.T) {
+	forEachImplementation(t, func(t *testing.T, implementation string) {
+		list := newList[int](implementation)
+		cursor := list.(CursorList[int]).Cursor()
+		assert.False(t, cursor.Next())
+		_, err := cursor.Value()
+		assert.NotNil(t, err)
+
+		list.AddLast(1)
+		list.AddLast(2)
+		list.AddLast(3)
+		cursor.Reset()
+
+		values := []int{}
+		for cursor.Next() {
+			value, err := cursor.Value()
+			assert.Nil(t, err)
+			values = append(values, value)
+		}
+		assert.Equal(t, []int{1, 2, 3}, values)
+		assert.False(t, cursor.Next())
+
+		cursor.Reset()
+		assert.True(t, cursor.Next())
+		value, _ := cursor.Value()
+		assert.Equal(t, 1, value)
+	})
+}
+
+func TestCursorRemove(t *testing.T) {
+	forEachImplementation(t, func(t *testing.T, implementation string) {
+		list := newList[int](implementation)
+		for i := 1; i <= 6; i++ {
+			list.AddLast(i)
+		}
+
+		cursor := list.(CursorList[int]).Cursor()
+		assert.NotNil(t, cursor.Remove())
+		for cursor.Next() {
+			value, _ := cursor.Value()
+			if value%2 == 0 || value == 1 {
+				assert.Nil(t, cursor.Remove())
+				assert.NotNil(t, cursor.Remove())
+			}
+		}
+
+		assert.Equal(t, []int{3, 5}, list.ToSlice())
+		assert.Equal(t, 2, list.Size())
+		first, _ := list.First()
+		last, _ := list.Last()
+		assert.Equal(t, 3, first)
+		assert.Equal(t, 5, last)
+
+		cursor.Reset()
+		for cursor.Next() {
+			cursor.Remove()
+		}
+		assert.Equal(t, 0, list.Size())
+		_, err := list.Last()
+		assert.NotNil(t, err)
+
+		list.AddLast(7)
+		assert.Equal(t, []int{7}, list.ToSlice())
+	})
+}
+
+func TestCursorInsert(t *testing.T) {
+	forEachImplementation(t, func(t *testing.T, implementation string) {
+		list := newList[string](implementation)
+		cursor := list.(CursorList[string]).Cursor()
+		cursor.InsertBefore("b")
+		cursor.InsertAfter("c")
+		assert.Equal(t, []string{"b", "c"}, list.ToSlice())
+
+		visited := []string{}
+		cursor.Reset()
+		for cursor.Next() {
+			value, _ := cursor.Value()
+			visited = append(visited, value)
+			switch value {
+			case "b":
+				cursor.InsertBefore("a")
+				cursor.InsertAfter("bb")
+			case "c":
+				cursor.Remove()
+				cursor.InsertBefore("cc")
+			}
+		}
+		cursor.InsertAfter("e")
+		cursor.InsertBefore("d")
+
+		assert.Equal(t, []string{"b", "bb", "c"}, visited)
+		assert.Equal(t, []string{"a", "b", "bb", "cc", "d", "e"}, list.ToSlice())
+		assert.Equal(t, 6, list.Size())
+		first, _ := list.First()
+		last, _ := list.Last()
+		assert.Equal(t, "a", first)
+		assert.Equal(t, "e", last)
+	})
+}
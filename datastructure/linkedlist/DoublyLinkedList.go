@@ -0,0 +1,476 @@
+package linkedlist
+
+import (
+	"fmt"
+	"iter"
+
+	"github.com/OmarFaruk-0x01/go_algorithms/datastructure/errs"
+)
+
+// doublyNode is a cell of a doubly LinkedList. Besides the element it keeps a pointer to both of its neighbours.
+//
+// Parameters:
+//
+//	T: The type of elements stored in the linked list. It can be any type.
+//
+// Fields:
+//
+//	value: Actual element for the node.
+//	prev: A pointer to the previous node of this node.
+//	next: A pointer to the next node of this node.
+type doublyNode[T any] struct {
+	value T
+	prev  *doublyNode[T]
+	next  *doublyNode[T]
+}
+
+// doublyLinkedList is a LinkedList where every element is linked to both the next and the previous element in the sequence. Keeping the backward link makes removal at both ends O(1) and allows the list to be traversed from the tail to the head.
+//
+// Parameters:
+//
+//	T: The type of elements stored in the linked list. It can be any type.
+//
+// Fields:
+//
+//	first: A pointer to the first element (head) of the linked list.
+//	last: A pointer to the last element (tail) of the linked list.
+//	size: The number of elements stored in the linked list.
+//
+// Example:
+//
+//	// Create a new doubly linked list of integers
+//	myList := linkedlist.NewDoubly[int]()
+//	myList.AddLast(5)
+//	myList.AddLast(10)
+//	myList.RemoveLast() // O(1), myList: 5
+type doublyLinkedList[T any] struct {
+	first *doublyNode[T]
+	last  *doublyNode[T]
+	size  int
+	_nil  T
+}
+
+// Create a new Instance of a doubly LinkedList with the Given Type
+//
+// Example:
+//
+//	myList := linkedlist.NewDoubly[int]()
+//	myList.AddLast(1)
+//	myList.AddLast(2)
+//	myList.AddFirst(3)
+//	myList.ToSlice() // [3 1 2]
+func NewDoubly[T any]() doublyLinkedList[T] {
+	return doublyLinkedList[T]{size: 0}
+}
+
+// Create a new Instance of a doubly LinkedList holding the items of the given slice in the same order. The slice itself is not retained.
+//
+// Complexity:
+//
+//	Time - O(n)
+//	Space - O(n)
+func DoublyFromSlice[T any](items []T) doublyLinkedList[T] {
+	list := NewDoubly[T]()
+	for _, item := range items {
+		list.AddLast(item)
+	}
+	return list
+}
+
+// Create a new Instance of a doubly LinkedList holding the given items in the same order.
+//
+// Example:
+//
+//	myList := linkedlist.DoublyOf(1, 2, 3)
+//	myList.ToSlice() // [1 2 3]
+func DoublyOf[T any](items ...T) doublyLinkedList[T] {
+	return DoublyFromSlice(items)
+}
+
+// AddFirst adds an element to the beginning of the linked list.
+//
+// Complexity:
+//
+//	Time - O(1)
+//	Space - O(1)
+//
+// Example:
+//
+//	myList := linkedlist.NewDoubly[int]()
+//	myList.AddFirst(100) // myList: 100
+//	myList.AddFirst(102) // myList: 102 <-> 100
+func (l *doublyLinkedList[T]) AddFirst(item T) {
+	newNode := &doublyNode[T]{value: item}
+	if l.isEmpty() {
+		l.first = newNode
+		l.last = newNode
+	} else {
+		newNode.next = l.first
+		l.first.prev = newNode
+		l.first = newNode
+	}
+	l.size++
+}
+
+// AddLast adds an element to the end of the linked list.
+//
+// Complexity:
+//
+//	Time - O(1)
+//	Space - O(1)
+//
+// Example:
+//
+//	myList := linkedlist.NewDoubly[string]()
+//	myList.AddLast("one") // myList: one
+//	myList.AddLast("two") // myList: one <-> two
+func (l *doublyLinkedList[T]) AddLast(item T) {
+	newNode := &doublyNode[T]{value: item}
+	if l.isEmpty() {
+		l.first = newNode
+		l.last = newNode
+	} else {
+		newNode.prev = l.last
+		l.last.next = newNode
+		l.last = newNode
+	}
+	l.size++
+}
+
+// InsertAt adds an element to the given index of the linked list. An index equal to the size of the list adds the item to the end of the list. A negative index or an index bigger then the size of the list panics with an *errs.IndexOutOfRangeError, the same as slices.Insert. The position is reached from whichever end of the list is closer, so at most half of the list is walked.
+//
+// Complexity:
+//
+//	Time - O(1) (Best Case)
+//	Time - O(n) (Worst Case)
+//	Space - O(1)
+//
+// Example:
+//
+//	myList := linkedlist.NewDoubly[int]()
+//	myList.InsertAt(100, 0) // myList: 100
+//	myList.InsertAt(102, 0) // myList: 102 <-> 100
+//	myList.InsertAt(101, 1) // myList: 102 <-> 101 <-> 100
+func (l *doublyLinkedList[T]) InsertAt(item T, index int) {
+	if index < 0 || index > l.size {
+		panic(errs.IndexOutOfRange(index, l.size))
+	}
+	switch {
+	case index == 0:
+		l.AddFirst(item)
+	case index == l.size:
+		l.AddLast(item)
+	case index > 0 && index < l.size:
+		nextNode := l.nodeAt(index)
+		newNode := &doublyNode[T]{value: item, prev: nextNode.prev, next: nextNode}
+		nextNode.prev.next = newNode
+		nextNode.prev = newNode
+		l.size++
+	}
+}
+
+// RemoveFirst remove an element from the beginning of the linked list. If the list is empty it will return a error otherwise nil.
+//
+// Complexity:
+//
+//	Time - O(1)
+func (l *doublyLinkedList[T]) RemoveFirst() error {
+	if l.isEmpty() {
+		return ErrEmpty
+	}
+	l.unlink(l.first)
+	return nil
+}
+
+// RemoveLast remove an element from the end of the linked list. If the list is empty it will return a error otherwise nil.
+//
+// Complexity:
+//
+//	Time - O(1)
+//
+// Example:
+//
+//	myList := linkedlist.NewDoubly[int]()
+//	myList.AddLast(100) // myList: 100
+//	myList.AddLast(102) // myList: 100 <-> 102
+//	_ := myList.RemoveLast() // myList:  100
+func (l *doublyLinkedList[T]) RemoveLast() error {
+	if l.isEmpty() {
+		return ErrEmpty
+	}
+	l.unlink(l.last)
+	return nil
+}
+
+// RemoveAt remove an element from the given index of the linked list. If the list is empty it will return a error otherwise nil. The element is reached from whichever end of the list is closer, so at most half of the list is walked.
+//
+// Complexity:
+//
+//	Time - O(1) (Best Case)
+//	Time - O(n) (Worst Case)
+//	Space - O(1)
+func (l *doublyLinkedList[T]) RemoveAt(index int) error {
+	if l.isEmpty() {
+		return ErrEmpty
+	}
+	if index < 0 || index >= l.size {
+		return errs.IndexOutOfRange(index, l.size)
+	}
+	l.unlink(l.nodeAt(index))
+	return nil
+}
+
+// Find the index of the first element of the linked list the given function matches.
+//
+// Returns:
+//
+//	index int: Item Index If a matching item exist in the list. Otherwise -1
+//
+// Complexity:
+//
+//	Time - O(1) (Best Case)
+//	Time - O(n) (Worst Case)
+func (l doublyLinkedList[T]) IndexFunc(match func(item T) bool) int {
+	index := 0
+	for node := l.first; node != nil; node = node.next {
+		if match(node.value) {
+			return index
+		}
+		index++
+	}
+	return -1
+}
+
+// Traversal all elements of the linked list from the beginning to end.
+//
+// Complexity:
+//
+//	Time - O(n)
+//
+// Deprecated: The function can not stop the traversal early. Use All instead, which stops as soon as the loop body breaks.
+func (l doublyLinkedList[T]) Traversal(traversal_func func(item T, index int)) {
+	for index, item := range l.All() {
+		traversal_func(item, index)
+	}
+}
+
+// ReverseTraversal all elements of the linked list from the end to beginning. The index passed to the function is still the position of the item counted from the beginning of the list.
+//
+// Complexity:
+//
+//	Time - O(n)
+//
+// Example:
+//
+//	myList := linkedlist.NewDoubly[int]()
+//	myList.AddLast(100) // myList: 100
+//	myList.AddLast(102) // myList: 100 <-> 102
+//	myList.ReverseTraversal(func (item int, index int) {
+//		fmt.Println(item, index) // 102 1, 100 0
+//	})
+//
+// Deprecated: The function can not stop the traversal early. Use Backward instead, which stops as soon as the loop body breaks.
+func (l doublyLinkedList[T]) ReverseTraversal(traversal_func func(item T, index int)) {
+	for index, item := range l.Backward() {
+		traversal_func(item, index)
+	}
+}
+
+// All returns an iterator over the index and the value of every element of the linked list from the beginning to end. The iteration stops as soon as the loop body breaks.
+//
+// Complexity:
+//
+//	Time - O(n)
+//	Space - O(1)
+func (l doublyLinkedList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		index := 0
+		for node := l.first; node != nil; node = node.next {
+			if !yield(index, node.value) {
+				return
+			}
+			index++
+		}
+	}
+}
+
+// Backward returns an iterator over the index and the value of every element of the linked list from the end to beginning. The index is still the position of the item counted from the beginning of the list.
+//
+// Complexity:
+//
+//	Time - O(n)
+//	Space - O(1)
+//
+// Example:
+//
+//	myList := linkedlist.NewDoubly[int]()
+//	myList.AddLast(100) // myList: 100
+//	myList.AddLast(102) // myList: 100 <-> 102
+//	for index, item := range myList.Backward() {
+//		fmt.Println(index, item) // 1 102, 0 100
+//	}
+func (l doublyLinkedList[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		index := l.size - 1
+		for node := l.last; node != nil; node = node.prev {
+			if !yield(index, node.value) {
+				return
+			}
+			index--
+		}
+	}
+}
+
+// Values returns an iterator over the values of the linked list from the beginning to end.
+//
+// Complexity:
+//
+//	Time - O(n)
+//	Space - O(1)
+func (l doublyLinkedList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for node := l.first; node != nil; node = node.next {
+			if !yield(node.value) {
+				return
+			}
+		}
+	}
+}
+
+// Create a copy of a LinkedList to an slice.
+//
+// Complexity:
+//
+//	Time - O(n)
+func (l doublyLinkedList[T]) ToSlice() []T {
+	values := make([]T, 0, l.size)
+	for item := range l.Values() {
+		values = append(values, item)
+	}
+	return values
+}
+
+// Get ta total size of the linkedlist.
+//
+// Complexity:
+//
+//	Time - O(1)
+func (l doublyLinkedList[T]) Size() int {
+	return l.size
+}
+
+// Check an item the given function matches exist or not in the linkedlist.
+//
+// Complexity:
+//
+//	Time - O(n)
+func (l doublyLinkedList[T]) ContainsFunc(match func(item T) bool) bool {
+	for node := l.first; node != nil; node = node.next {
+		if match(node.value) {
+			return true
+		}
+	}
+	return false
+}
+
+// Get the first item of the linkedlist.
+//
+// Complexity:
+//
+//	Time - O(1)
+func (l doublyLinkedList[T]) First() (T, error) {
+	if l.first == nil {
+		return l._nil, ErrEmpty
+	}
+
+	return l.first.value, nil
+}
+
+// Get the last item of the linkedlist.
+//
+// Complexity:
+//
+//	Time - O(1)
+func (l doublyLinkedList[T]) Last() (T, error) {
+	if l.last == nil {
+		return l._nil, ErrEmpty
+	}
+
+	return l.last.value, nil
+}
+
+// Clone creates a copy of the linked list with its own nodes, so changing one list does not affect the other.
+//
+// Complexity:
+//
+//	Time - O(n)
+//	Space - O(n)
+func (l doublyLinkedList[T]) Clone() doublyLinkedList[T] {
+	clone := NewDoubly[T]()
+	for node := l.first; node != nil; node = node.next {
+		clone.AddLast(node.value)
+	}
+	return clone
+}
+
+// EqualFunc reports whether the other LinkedList holds the same elements in the same order, comparing the elements with the given function.
+//
+// Complexity:
+//
+//	Time - O(1) (Best Case)
+//	Time - O(n) (Worst Case)
+func (l doublyLinkedList[T]) EqualFunc(other LinkedList[T], equal func(a, b T) bool) bool {
+	if l.size != other.Size() {
+		return false
+	}
+	node := l.first
+	for item := range other.Values() {
+		if node == nil || !equal(node.value, item) {
+			return false
+		}
+		node = node.next
+	}
+	return true
+}
+
+// nodeAt walks to the node at the given index from the closer end of the list. The index must be in range.
+func (l *doublyLinkedList[T]) nodeAt(index int) *doublyNode[T] {
+	if index < l.size/2 {
+		node := l.first
+		for i := 0; i < index; i++ {
+			node = node.next
+		}
+		return node
+	}
+	node := l.last
+	for i := l.size - 1; i > index; i-- {
+		node = node.prev
+	}
+	return node
+}
+
+// unlink detaches the given node from the list and fixes the first/last pointers.
+func (l *doublyLinkedList[T]) unlink(target *doublyNode[T]) {
+	if target.prev == nil {
+		l.first = target.next
+	} else {
+		target.prev.next = target.next
+	}
+	if target.next == nil {
+		l.last = target.prev
+	} else {
+		target.next.prev = target.prev
+	}
+	target.prev = nil
+	target.next = nil
+	l.size--
+}
+
+func (l doublyLinkedList[T]) isEmpty() bool {
+	return l.size == 0
+}
+
+// Overwrite the Stringer Interface Function For this Struct
+func (l doublyLinkedList[T]) String() string {
+	return fmt.Sprintf("%v", l.ToSlice())
+}
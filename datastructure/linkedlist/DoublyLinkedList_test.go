@@ -0,0 +1,67 @@
+package linkedlist
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDoublyRemoveLast(t *testing.T) {
+	list := NewDoubly[int]()
+	err := list.RemoveLast()
+	assert.NotNil(t, err)
+
+	list.AddLast(1)
+	list.AddLast(2)
+	list.AddLast(3)
+
+	assert.Nil(t, list.RemoveLast())
+	l, _ := list.Last()
+	assert.Equal(t, 2, l)
+	assert.Nil(t, list.RemoveLast())
+	assert.Nil(t, list.RemoveLast())
+	assert.Equal(t, 0, list.Size())
+
+	_, err = list.First()
+	assert.NotNil(t, err)
+	_, err = list.Last()
+	assert.NotNil(t, err)
+
+	list.AddFirst(4)
+	assert.Equal(t, []int{4}, list.ToSlice())
+}
+
+func TestDoublyReverseTraversal(t *testing.T) {
+	list := NewDoubly[string]()
+	list.AddLast("Omar")
+	list.AddLast("Faruk")
+	list.AddLast("Sadik")
+	list.InsertAt("Ahmad", 2)
+	list.RemoveAt(1)
+
+	items := []string{}
+	indexes := []int{}
+	list.ReverseTraversal(func(item string, index int) {
+		items = append(items, item)
+		indexes = append(indexes, index)
+	})
+
+	assert.Equal(t, []string{"Sadik", "Ahmad", "Omar"}, items)
+	assert.Equal(t, []int{2, 1, 0}, indexes)
+}
+
+func TestDoublyBackward(t *testing.T) {
+	list := NewDoubly[int]()
+	for i := 1; i <= 5; i++ {
+		list.AddLast(i)
+	}
+
+	items := []int{}
+	for index, item := range list.Backward() {
+		if index < 2 {
+			break
+		}
+		items = append(items, item)
+	}
+	assert.Equal(t, []int{5, 4, 3}, items)
+}
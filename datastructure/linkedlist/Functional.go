@@ -0,0 +1,169 @@
+package linkedlist
+
+import "github.com/OmarFaruk-0x01/go_algorithms/datastructure/errs"
+
+// ErrNotFound is returned by FindFirst when no item matches the predicate.
+var ErrNotFound = errs.ErrNotFound
+
+// Map creates a new linked list holding the result of the given function for every element of the list, in the same order.
+//
+// Parameters:
+//
+//	list: The source linked list.
+//	mapper: A function that transforms one element.
+//
+// Complexity:
+//
+//	Time - O(n)
+//	Space - O(n)
+//
+// Example:
+//
+//	myList := linkedlist.New[int]()
+//	myList.AddLast(1)
+//	myList.AddLast(2)
+//	labels := linkedlist.Map[int, string](&myList, strconv.Itoa) // labels: "1" -> "2"
+func Map[T any, U any](list LinkedList[T], mapper func(item T) U) linkedList[U] {
+	result := New[U]()
+	for item := range list.Values() {
+		result.AddLast(mapper(item))
+	}
+	return result
+}
+
+// Filter creates a new linked list holding only the elements the predicate returns true for, in the same order.
+//
+// Parameters:
+//
+//	list: The source linked list.
+//	predicate: A function that decides whether an element is kept.
+//
+// Complexity:
+//
+//	Time - O(n)
+//	Space - O(n)
+//
+// Example:
+//
+//	myList := linkedlist.New[int]()
+//	myList.AddLast(1)
+//	myList.AddLast(2)
+//	myList.AddLast(3)
+//	odd := linkedlist.Filter[int](&myList, func(item int) bool { return item%2 == 1 }) // odd: 1 -> 3
+func Filter[T any](list LinkedList[T], predicate func(item T) bool) linkedList[T] {
+	result := New[T]()
+	for item := range list.Values() {
+		if predicate(item) {
+			result.AddLast(item)
+		}
+	}
+	return result
+}
+
+// Reduce folds every element of the linked list from the beginning to end into a single value.
+//
+// Parameters:
+//
+//	list: The source linked list.
+//	initial: The starting value of the accumulator.
+//	reducer: A function that combines the accumulator with one element.
+//
+// Complexity:
+//
+//	Time - O(n)
+//	Space - O(1)
+//
+// Example:
+//
+//	myList := linkedlist.New[int]()
+//	myList.AddLast(1)
+//	myList.AddLast(2)
+//	myList.AddLast(3)
+//	linkedlist.Reduce[int](&myList, 0, func(sum int, item int) int { return sum + item }) // 6
+func Reduce[T any, U any](list LinkedList[T], initial U, reducer func(accumulator U, item T) U) U {
+	accumulator := initial
+	for item := range list.Values() {
+		accumulator = reducer(accumulator, item)
+	}
+	return accumulator
+}
+
+// FindFirst returns the first element the predicate returns true for. If no element matches it will return a error.
+//
+// Complexity:
+//
+//	Time - O(1) (Best Case)
+//	Time - O(n) (Worst Case)
+//
+// Example:
+//
+//	myList := linkedlist.New[int]()
+//	myList.AddLast(1)
+//	myList.AddLast(2)
+//	linkedlist.FindFirst[int](&myList, func(item int) bool { return item > 1 }) // 2, nil
+func FindFirst[T any](list LinkedList[T], predicate func(item T) bool) (T, error) {
+	for item := range list.Values() {
+		if predicate(item) {
+			return item, nil
+		}
+	}
+	var _nil T
+	return _nil, ErrNotFound
+}
+
+// Some reports whether the predicate returns true for at least one element. It stops at the first match and returns false for an empty list.
+//
+// Complexity:
+//
+//	Time - O(1) (Best Case)
+//	Time - O(n) (Worst Case)
+func Some[T any](list LinkedList[T], predicate func(item T) bool) bool {
+	for item := range list.Values() {
+		if predicate(item) {
+			return true
+		}
+	}
+	return false
+}
+
+// Every reports whether the predicate returns true for every element. It stops at the first mismatch and returns true for an empty list.
+//
+// Complexity:
+//
+//	Time - O(1) (Best Case)
+//	Time - O(n) (Worst Case)
+func Every[T any](list LinkedList[T], predicate func(item T) bool) bool {
+	for item := range list.Values() {
+		if !predicate(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// Partition splits the elements of the linked list into two new lists in a single pass. The first one holds the elements the predicate returns true for, the second one holds the rest. Both keep the original order.
+//
+// Complexity:
+//
+//	Time - O(n)
+//	Space - O(n)
+//
+// Example:
+//
+//	myList := linkedlist.New[int]()
+//	myList.AddLast(1)
+//	myList.AddLast(2)
+//	myList.AddLast(3)
+//	odd, even := linkedlist.Partition[int](&myList, func(item int) bool { return item%2 == 1 }) // odd: 1 -> 3, even: 2
+func Partition[T any](list LinkedList[T], predicate func(item T) bool) (linkedList[T], linkedList[T]) {
+	matched := New[T]()
+	rest := New[T]()
+	for item := range list.Values() {
+		if predicate(item) {
+			matched.AddLast(item)
+		} else {
+			rest.AddLast(item)
+		}
+	}
+	return matched, rest
+}
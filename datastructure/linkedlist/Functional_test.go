@@ -0,0 +1,110 @@
+package linkedlist
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func isEven(item int) bool {
+	return item%2 == 0
+}
+
+func TestMap(t *testing.T) {
+	forEachImplementation(t, func(t *testing.T, implementation string) {
+		list := newList[int](implementation)
+		labels := Map(list, strconv.Itoa)
+		assert.Equal(t, 0, labels.Size())
+
+		list.AddLast(1)
+		list.AddLast(2)
+		list.AddLast(3)
+		labels = Map(list, strconv.Itoa)
+		assert.Equal(t, []string{"1", "2", "3"}, labels.ToSlice())
+		assert.Equal(t, 3, labels.Size())
+		last, _ := labels.Last()
+		assert.Equal(t, "3", last)
+		assert.Equal(t, []int{1, 2, 3}, list.ToSlice())
+	})
+}
+
+func TestFilter(t *testing.T) {
+	forEachImplementation(t, func(t *testing.T, implementation string) {
+		list := newList[int](implementation)
+		for i := 1; i <= 6; i++ {
+			list.AddLast(i)
+		}
+		even := Filter(list, isEven)
+		assert.Equal(t, []int{2, 4, 6}, even.ToSlice())
+		assert.Equal(t, 6, list.Size())
+
+		none := Filter(list, func(item int) bool { return item > 10 })
+		assert.Equal(t, 0, none.Size())
+		_, err := none.First()
+		assert.NotNil(t, err)
+	})
+}
+
+func TestReduce(t *testing.T) {
+	forEachImplementation(t, func(t *testing.T, implementation string) {
+		person := newList[Person](implementation)
+		assert.Equal(t, uint(0), Reduce(person, uint(0), func(sum uint, p Person) uint { return sum + p.age }))
+
+		person.AddLast(Person{name: "Omar Faruk", age: 20})
+		person.AddLast(Person{name: "Tanvir Raj", age: 25})
+		assert.Equal(t, uint(45), Reduce(person, uint(0), func(sum uint, p Person) uint { return sum + p.age }))
+		assert.Equal(t, "Omar Faruk, Tanvir Raj", Reduce(person, "", func(names string, p Person) string {
+			if names == "" {
+				return p.name
+			}
+			return names + ", " + p.name
+		}))
+	})
+}
+
+func TestFindFirst(t *testing.T) {
+	forEachImplementation(t, func(t *testing.T, implementation string) {
+		list := newList[int](implementation)
+		_, err := FindFirst(list, isEven)
+		assert.NotNil(t, err)
+
+		list.AddLast(1)
+		list.AddLast(4)
+		list.AddLast(6)
+		item, err := FindFirst(list, isEven)
+		assert.Nil(t, err)
+		assert.Equal(t, 4, item)
+	})
+}
+
+func TestSomeEvery(t *testing.T) {
+	forEachImplementation(t, func(t *testing.T, implementation string) {
+		list := newList[int](implementation)
+		assert.False(t, Some(list, isEven))
+		assert.True(t, Every(list, isEven))
+
+		list.AddLast(2)
+		list.AddLast(4)
+		assert.True(t, Some(list, isEven))
+		assert.True(t, Every(list, isEven))
+
+		list.AddLast(5)
+		assert.True(t, Some(list, isEven))
+		assert.False(t, Every(list, isEven))
+	})
+}
+
+func TestPartition(t *testing.T) {
+	forEachImplementation(t, func(t *testing.T, implementation string) {
+		list := newList[int](implementation)
+		for i := 1; i <= 5; i++ {
+			list.AddLast(i)
+		}
+		even, odd := Partition(list, isEven)
+		assert.Equal(t, []int{2, 4}, even.ToSlice())
+		assert.Equal(t, []int{1, 3, 5}, odd.ToSlice())
+		assert.Equal(t, 2, even.Size())
+		assert.Equal(t, 3, odd.Size())
+	})
+}
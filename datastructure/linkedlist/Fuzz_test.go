@@ -0,0 +1,176 @@
+package linkedlist
+
+import (
+	"cmp"
+	"errors"
+	"slices"
+	"testing"
+)
+
+// Operations of FuzzLinkedList. Every operation takes two bytes of the fuzz input: the operation and its argument.
+const (
+	opAddFirst = iota
+	opAddLast
+	opInsertAt
+	opRemoveAt
+	opRemoveFirst
+	opRemoveLast
+	opCount
+)
+
+// FuzzLinkedList replays the fuzz input as a sequence of mutations on both lists and on a slice model, checking after every step that the lists still hold what the model holds.
+func FuzzLinkedList(f *testing.F) {
+	f.Add([]byte{opAddLast, 1, opAddLast, 2, opRemoveLast, 0, opRemoveLast, 0, opAddFirst, 3})
+	f.Add([]byte{opAddFirst, 1, opRemoveFirst, 0, opAddLast, 2, opInsertAt, 9})
+	f.Add([]byte{opInsertAt, 0, opInsertAt, 1, opInsertAt, 2, opRemoveAt, 1, opRemoveAt, 255})
+
+	f.Fuzz(func(t *testing.T, operations []byte) {
+		for _, implementation := range implementations {
+			list := newList[int](implementation)
+			model := []int{}
+			for step := 0; step+1 < len(operations); step += 2 {
+				operation, argument := operations[step]%opCount, operations[step+1]
+				model = applyOperation(t, list, model, operation, argument)
+				checkAgainstModel(t, implementation, step/2, list, model)
+			}
+		}
+	})
+}
+
+// applyOperation runs one operation on the list and on the model and returns the new model.
+func applyOperation(t *testing.T, list LinkedList[int], model []int, operation byte, argument byte) []int {
+	item := int(argument)
+	switch operation {
+	case opAddFirst:
+		list.AddFirst(item)
+		return slices.Insert(model, 0, item)
+	case opAddLast:
+		list.AddLast(item)
+		return append(model, item)
+	case opInsertAt:
+		// The argument is read as signed and may point one past the end, so both kinds of rejected indexes are covered.
+		index := int(int8(argument)) % (len(model) + 2)
+		if index < 0 || index > len(model) {
+			expectPanic(t, func() { list.InsertAt(item, index) }, ErrIndexOutOfRange)
+			return model
+		}
+		list.InsertAt(item, index)
+		return slices.Insert(model, index, item)
+	case opRemoveAt:
+		// The argument is read as signed so negative indexes are covered as well.
+		index := int(int8(argument))
+		err := list.RemoveAt(index)
+		switch {
+		case len(model) == 0:
+			expectError(t, err, ErrEmpty)
+		case index < 0 || index >= len(model):
+			expectError(t, err, ErrIndexOutOfRange)
+		default:
+			expectError(t, err, nil)
+			return slices.Delete(model, index, index+1)
+		}
+	case opRemoveFirst:
+		err := list.RemoveFirst()
+		if len(model) == 0 {
+			expectError(t, err, ErrEmpty)
+			return model
+		}
+		expectError(t, err, nil)
+		return model[1:]
+	case opRemoveLast:
+		err := list.RemoveLast()
+		if len(model) == 0 {
+			expectError(t, err, ErrEmpty)
+			return model
+		}
+		expectError(t, err, nil)
+		return model[:len(model)-1]
+	}
+	return model
+}
+
+func expectError(t *testing.T, err error, target error) {
+	t.Helper()
+	if target == nil && err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if target != nil && !errors.Is(err, target) {
+		t.Fatalf("got error %v, want %v", err, target)
+	}
+}
+
+func expectPanic(t *testing.T, operation func(), target error) {
+	t.Helper()
+	defer func() {
+		t.Helper()
+		err, _ := recover().(error)
+		if !errors.Is(err, target) {
+			t.Fatalf("got panic %v, want %v", err, target)
+		}
+	}()
+	operation()
+}
+
+// checkAgainstModel fails the fuzz run as soon as the size, the ends or the items of the list differ from the model.
+func checkAgainstModel(t *testing.T, implementation string, step int, list LinkedList[int], model []int) {
+	t.Helper()
+	if list.Size() != len(model) {
+		t.Fatalf("%s step %d: size %d, want %d", implementation, step, list.Size(), len(model))
+	}
+	if items := list.ToSlice(); !slices.Equal(items, model) {
+		t.Fatalf("%s step %d: items %v, want %v", implementation, step, items, model)
+	}
+	if values := slices.Collect(list.Values()); !slices.Equal(values, model) {
+		t.Fatalf("%s step %d: iterated %v, want %v", implementation, step, values, model)
+	}
+	first, firstErr := list.First()
+	last, lastErr := list.Last()
+	if len(model) == 0 {
+		if !errors.Is(firstErr, ErrEmpty) || !errors.Is(lastErr, ErrEmpty) {
+			t.Fatalf("%s step %d: First and Last of an empty list returned %v and %v", implementation, step, firstErr, lastErr)
+		}
+		return
+	}
+	if firstErr != nil || first != model[0] {
+		t.Fatalf("%s step %d: first %d (%v), want %d", implementation, step, first, firstErr, model[0])
+	}
+	if lastErr != nil || last != model[len(model)-1] {
+		t.Fatalf("%s step %d: last %d (%v), want %d", implementation, step, last, lastErr, model[len(model)-1])
+	}
+	if doubly, ok := list.(*doublyLinkedList[int]); ok {
+		backward := []int{}
+		for _, item := range doubly.Backward() {
+			backward = append(backward, item)
+		}
+		slices.Reverse(backward)
+		if !slices.Equal(backward, model) {
+			t.Fatalf("%s step %d: iterated backward %v, want reversed %v", implementation, step, backward, model)
+		}
+	}
+}
+
+// FuzzSortFunc checks that SortFunc agrees with a stable sort of the same items. Only the high nibble of every byte is compared, so equal keys are common and stability is exercised.
+func FuzzSortFunc(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{0x31, 0x12, 0x33, 0x14, 0x05})
+	f.Add([]byte{0xff, 0xf0, 0x0f, 0x00, 0x80, 0x8f, 0x7f})
+
+	f.Fuzz(func(t *testing.T, items []byte) {
+		compare := func(a, b byte) int { return cmp.Compare(a>>4, b>>4) }
+		want := slices.Clone(items)
+		slices.SortStableFunc(want, compare)
+		for _, implementation := range implementations {
+			list := newList[byte](implementation)
+			for _, item := range items {
+				list.AddLast(item)
+			}
+			SortFunc(list, compare)
+			if got := list.ToSlice(); !slices.Equal(got, want) {
+				t.Fatalf("%s: sorted %v, want %v", implementation, got, want)
+			}
+			if last, err := list.Last(); len(want) > 0 && (err != nil || last != want[len(want)-1]) {
+				t.Fatalf("%s: last %d (%v) after sorting, want %d", implementation, last, err, want[len(want)-1])
+			}
+		}
+	})
+}
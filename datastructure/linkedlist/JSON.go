@@ -0,0 +1,93 @@
+package linkedlist
+
+import (
+	"bytes"
+	"encoding/json"
+	"iter"
+)
+
+// MarshalJSON encodes the linked list as a JSON array of its items from the first to the last.
+//
+// Complexity:
+//
+//	Time - O(n)
+//	Space - O(n)
+//
+// Example:
+//
+//	myList := linkedlist.Of(1, 2, 3)
+//	data, _ := json.Marshal(myList) // [1,2,3]
+func (l linkedList[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSONArray(l.Values())
+}
+
+// UnmarshalJSON replaces the items of the linked list with the items of a JSON array, the first item of the array becomes the first item of the list. A JSON null empties the list.
+//
+// Complexity:
+//
+//	Time - O(n)
+//	Space - O(n)
+//
+// Example:
+//
+//	myList := linkedlist.New[int]()
+//	_ = json.Unmarshal([]byte("[1,2,3]"), &myList) // myList: 1 -> 2 -> 3
+func (l *linkedList[T]) UnmarshalJSON(data []byte) error {
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	*l = FromSlice(items)
+	return nil
+}
+
+// MarshalJSON encodes the doubly linked list as a JSON array of its items from the first to the last.
+//
+// Complexity:
+//
+//	Time - O(n)
+//	Space - O(n)
+func (l doublyLinkedList[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSONArray(l.Values())
+}
+
+// UnmarshalJSON replaces the items of the doubly linked list with the items of a JSON array, the first item of the array becomes the first item of the list. A JSON null empties the list.
+//
+// Complexity:
+//
+//	Time - O(n)
+//	Space - O(n)
+func (l *doublyLinkedList[T]) UnmarshalJSON(data []byte) error {
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	*l = DoublyFromSlice(items)
+	return nil
+}
+
+// marshalJSONArray encodes the items one by one into a JSON array, so the list does not have to be copied into a slice first.
+func marshalJSONArray[T any](items iter.Seq[T]) ([]byte, error) {
+	var buffer bytes.Buffer
+	buffer.WriteByte('[')
+	first := true
+	var err error
+	items(func(item T) bool {
+		var data []byte
+		data, err = json.Marshal(item)
+		if err != nil {
+			return false
+		}
+		if !first {
+			buffer.WriteByte(',')
+		}
+		buffer.Write(data)
+		first = false
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	buffer.WriteByte(']')
+	return buffer.Bytes(), nil
+}
@@ -0,0 +1,77 @@
+package linkedlist
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// member is like Person but with exported fields, so encoding/json can see them.
+type member struct {
+	Name string `json:"name"`
+	Age  uint   `json:"age"`
+}
+
+func TestMarshalJSON(t *testing.T) {
+	singly := Of(member{"Omar Faruk", 20}, member{"Tanvir Raj", 25})
+	data, err := json.Marshal(singly)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `[{"name":"Omar Faruk","age":20},{"name":"Tanvir Raj","age":25}]`, string(data))
+
+	doubly := DoublyOf(member{"Omar Faruk", 20}, member{"Tanvir Raj", 25})
+	doublyData, err := json.Marshal(&doubly)
+	assert.Nil(t, err)
+	assert.Equal(t, data, doublyData)
+
+	empty, err := json.Marshal(New[int]())
+	assert.Nil(t, err)
+	assert.Equal(t, "[]", string(empty))
+
+	_, err = json.Marshal(Of(func() {}))
+	assert.NotNil(t, err)
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	data := []byte(`[{"name":"Omar Faruk","age":20},{"name":"Tanvir Raj","age":25}]`)
+
+	singly := Of(member{"old", 1})
+	assert.Nil(t, json.Unmarshal(data, &singly))
+	assert.Equal(t, []member{{"Omar Faruk", 20}, {"Tanvir Raj", 25}}, singly.ToSlice())
+	last, _ := singly.Last()
+	assert.Equal(t, member{"Tanvir Raj", 25}, last)
+
+	doubly := NewDoubly[member]()
+	assert.Nil(t, json.Unmarshal(data, &doubly))
+	assert.True(t, singly.EqualFunc(&doubly, func(a, b member) bool { return a == b }))
+	assert.Equal(t, 2, doubly.Size())
+
+	assert.Nil(t, json.Unmarshal([]byte("null"), &doubly))
+	assert.Equal(t, 0, doubly.Size())
+	assert.NotNil(t, json.Unmarshal([]byte(`{"name":"x"}`), &singly))
+}
+
+func TestJSONRoundTripInStruct(t *testing.T) {
+	type team struct {
+		Members linkedList[member]       `json:"members"`
+		Queue   doublyLinkedList[string] `json:"queue"`
+	}
+	original := team{Members: Of(member{"a", 1}, member{"b", 2}), Queue: DoublyOf("x", "y", "z")}
+	data, err := json.Marshal(original)
+	assert.Nil(t, err)
+
+	var decoded team
+	assert.Nil(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, original.Members.ToSlice(), decoded.Members.ToSlice())
+	assert.Equal(t, []string{"z", "y", "x"}, collectBackward(decoded.Queue))
+	decoded.Members.AddLast(member{"c", 3})
+	assert.Equal(t, 2, original.Members.Size())
+}
+
+func collectBackward[T any](l doublyLinkedList[T]) []T {
+	items := []T{}
+	for _, item := range l.Backward() {
+		items = append(items, item)
+	}
+	return items
+}
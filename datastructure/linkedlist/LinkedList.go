@@ -1,43 +1,50 @@
 package linkedlist
 
 import (
-	"errors"
 	"fmt"
+	"iter"
+
+	"github.com/OmarFaruk-0x01/go_algorithms/datastructure/errs"
 )
 
-const (
-	index_out_of_bound = "index out of bound"
-	empty_list         = "list is empty"
-	remove_item        = "unable to remove item the list is empty"
-	unsupported_index  = "index must be a positive number"
+var (
+	// ErrEmpty is returned when an item is read or removed from an empty list.
+	ErrEmpty = errs.ErrEmpty
+	// ErrIndexOutOfRange is matched by the *errs.IndexOutOfRangeError returned for an index outside of the list.
+	ErrIndexOutOfRange = errs.ErrIndexOutOfRange
 )
 
 // Node is a cell of a LinkedList. It stores two data side by side. One is the actual element and Second is the pointer of the next node.
 //
 // Parameters:
 //
-//	T: The type of elements stored in the linked list. The type must be comparable.
+//	T: The type of elements stored in the linked list. It can be any type.
 //
 // Fields:
 //
 //	value: Actual element for the node.
 //	next: A pointer to the next node of this node.
-type node[T comparable] struct {
+type node[T any] struct {
 	value T
 	next  *node[T]
 }
 
-type LinkedList[T comparable] interface {
+type LinkedList[T any] interface {
 	AddFirst(item T)
 	AddLast(item T)
+	// InsertAt panics with an *errs.IndexOutOfRangeError when index is negative or greater than the size of the list.
 	InsertAt(item T, index int)
 	RemoveAt(index int) error
 	RemoveFirst() error
 	RemoveLast() error
+	// Deprecated: Use All, which can stop early.
 	Traversal(func(item T, index int))
-	FindIndex(item T) int
+	All() iter.Seq2[int, T]
+	Values() iter.Seq[T]
+	IndexFunc(match func(item T) bool) int
 	ToSlice() []T
-	Contains(item T) bool
+	ContainsFunc(match func(item T) bool) bool
+	EqualFunc(other LinkedList[T], equal func(a, b T) bool) bool
 	Size() int
 	First() (T, error)
 	Last() (T, error)
@@ -47,7 +54,7 @@ type LinkedList[T comparable] interface {
 //
 // Parameters:
 //
-//	T: The type of elements stored in the linked list. The type must be comparable.
+//	T: The type of elements stored in the linked list. It can be any type.
 //
 // Fields:
 //
@@ -57,7 +64,7 @@ type LinkedList[T comparable] interface {
 //
 // Description:
 //
-//	The LinkedList type is implemented as a generic struct in Go. The type parameter T specifies the type of elements stored in the linked list. The type parameter must be a comparable type, which means it supports the == and != operators.
+//	The LinkedList type is implemented as a generic struct in Go. The type parameter T specifies the type of elements stored in the linked list. T can be any type, the search methods take a function instead of relying on the == operator.
 //
 // Example:
 //
@@ -70,7 +77,7 @@ type LinkedList[T comparable] interface {
 //	firstElement := myList.First()
 //	// Get the last element in the list
 //	lastElement := myList.Last()
-type linkedList[T comparable] struct {
+type linkedList[T any] struct {
 	first *node[T]
 	last  *node[T]
 	size  int
@@ -93,10 +100,39 @@ type linkedList[T comparable] struct {
 //	myList.addFirst("This")
 //	myList.addLast("LinkedList")
 //	myList.ToSlice() // [This is a LinkedList]
-func New[T comparable]() linkedList[T] {
+func New[T any]() linkedList[T] {
 	return linkedList[T]{size: 0}
 }
 
+// Create a new Instance of LinkedList holding the items of the given slice in the same order. The slice itself is not retained.
+//
+// Complexity:
+//
+//	Time - O(n)
+//	Space - O(n)
+//
+// Example:
+//
+//	myList := linkedlist.FromSlice([]int{1, 2, 3})
+//	myList.ToSlice() // [1 2 3]
+func FromSlice[T any](items []T) linkedList[T] {
+	list := New[T]()
+	for _, item := range items {
+		list.AddLast(item)
+	}
+	return list
+}
+
+// Create a new Instance of LinkedList holding the given items in the same order.
+//
+// Example:
+//
+//	myList := linkedlist.Of("This", "is", "a", "LinkedList")
+//	myList.ToSlice() // [This is a LinkedList]
+func Of[T any](items ...T) linkedList[T] {
+	return FromSlice(items)
+}
+
 // AddFirst adds an element to the beginning of the linked list.
 //
 // Parameters:
@@ -154,7 +190,7 @@ func (l *linkedList[T]) AddLast(item T) {
 	l.size++
 }
 
-// InsertAt adds an element to the given index of the linked list. If the index is bigger then the size of the list It will add the new Item into the end of the list
+// InsertAt adds an element to the given index of the linked list. An index equal to the size of the list adds the item to the end of the list. A negative index or an index bigger then the size of the list panics with an *errs.IndexOutOfRangeError, the same as slices.Insert.
 //
 // Parameters:
 //
@@ -173,11 +209,14 @@ func (l *linkedList[T]) AddLast(item T) {
 //	myList.InsertAt(100, 0) // myList: 100
 //	myList.InsertAt(102, 1) // myList: 102 -> 100
 func (l *linkedList[T]) InsertAt(item T, index int) {
+	if index < 0 || index > l.size {
+		panic(errs.IndexOutOfRange(index, l.size))
+	}
 	switch {
 	case index == 0:
 		l.AddFirst(item)
 		return
-	case index >= l.size:
+	case index == l.size:
 		l.AddLast(item)
 		return
 	case index > 0 && index < l.size:
@@ -213,10 +252,13 @@ func (l *linkedList[T]) InsertAt(item T, index int) {
 //	_ := myList.RemoveFirst() // myList:  100
 func (l *linkedList[T]) RemoveFirst() error {
 	if l.isEmpty() {
-		return errors.New(remove_item)
+		return ErrEmpty
 	}
 	newFirst := l.first.next
 	l.first = newFirst
+	if newFirst == nil {
+		l.last = nil
+	}
 	l.size--
 	return nil
 }
@@ -235,7 +277,13 @@ func (l *linkedList[T]) RemoveFirst() error {
 //	_ := myList.RemoveLast() // myList:  102
 func (l *linkedList[T]) RemoveLast() error {
 	if l.isEmpty() {
-		return errors.New(remove_item)
+		return ErrEmpty
+	}
+	if l.size == 1 {
+		l.first = nil
+		l.last = nil
+		l.size--
+		return nil
 	}
 	count := 0
 	for node := l.first; node != nil; node = node.next {
@@ -271,13 +319,10 @@ func (l *linkedList[T]) RemoveLast() error {
 //	myList.RemoveAt(1) // myList:  103 -> 100
 func (l *linkedList[T]) RemoveAt(index int) error {
 	if l.isEmpty() {
-		return errors.New(remove_item)
+		return ErrEmpty
 	}
-	if index < 0 {
-		return errors.New(unsupported_index)
-	}
-	if index >= l.size {
-		return errors.New(index_out_of_bound)
+	if index < 0 || index >= l.size {
+		return errs.IndexOutOfRange(index, l.size)
 	}
 
 	switch {
@@ -306,15 +351,15 @@ func (l *linkedList[T]) RemoveAt(index int) error {
 	return nil
 }
 
-// Find the index an element from the linked list.
+// Find the index of the first element of the linked list the given function matches.
 //
 // Parameters:
 //
-//	item: A targeted item to find the index from the list
+//	match: A function that reports whether an element is the targeted one
 //
 // Returns:
 //
-//	index int: Item Index If the targeted item exist in the list. Otherwise -1
+//	index int: Item Index If a matching item exist in the list. Otherwise -1
 //
 // Complexity:
 //
@@ -323,14 +368,14 @@ func (l *linkedList[T]) RemoveAt(index int) error {
 //
 // Example:
 //
-//	myList := linkedlist.New[int]()
-//	myList.InsertAt(100, 0) // myList: 100
-//	myList.InsertAt(102, 1) // myList: 102 -> 100
-//	myList.FindIndex(102) // 1
-func (l *linkedList[T]) FindIndex(item T) int {
+//	myList := linkedlist.New[[]byte]()
+//	myList.AddLast([]byte("Omar"))
+//	myList.AddLast([]byte("Faruk"))
+//	myList.IndexFunc(func(item []byte) bool { return bytes.Equal(item, []byte("Faruk")) }) // 1
+func (l linkedList[T]) IndexFunc(match func(item T) bool) int {
 	index := 0
 	for node := l.first; node != nil; node = node.next {
-		if node.value == item {
+		if match(node.value) {
 			return index
 		}
 		index++
@@ -338,6 +383,32 @@ func (l *linkedList[T]) FindIndex(item T) int {
 	return -1
 }
 
+// Find the index an element from a linked list of comparable elements.
+//
+// Parameters:
+//
+//	list: The linked list to search
+//	item: A targeted item to find the index from the list
+//
+// Returns:
+//
+//	index int: Item Index If the targeted item exist in the list. Otherwise -1
+//
+// Complexity:
+//
+//	Time - O(1) (Best Case)
+//	Time - O(n) (Worst Case)
+//
+// Example:
+//
+//	myList := linkedlist.New[int]()
+//	myList.InsertAt(100, 0) // myList: 100
+//	myList.InsertAt(102, 1) // myList: 102 -> 100
+//	linkedlist.FindIndex[int](&myList, 102) // 1
+func FindIndex[T comparable](list LinkedList[T], item T) int {
+	return list.IndexFunc(func(value T) bool { return value == item })
+}
+
 // Traversal all elements of the linked list from the beginning to end.
 //
 // Parameters:
@@ -356,11 +427,67 @@ func (l *linkedList[T]) FindIndex(item T) int {
 //	myList.Traversal(func (item int, index int) {
 //		fmt.Println(item) // 102, 100
 //	})
+//
+// Deprecated: The function can not stop the traversal early. Use All instead, which stops as soon as the loop body breaks:
+//
+//	for index, item := range myList.All() {
+//		if item == 102 {
+//			break
+//		}
+//	}
 func (l linkedList[T]) Traversal(traversal_func func(item T, index int)) {
-	index := 0
-	for node := l.first; node != nil; node = node.next {
-		traversal_func(node.value, index)
-		index++
+	for index, item := range l.All() {
+		traversal_func(item, index)
+	}
+}
+
+// All returns an iterator over the index and the value of every element of the linked list from the beginning to end. The iteration stops as soon as the loop body breaks.
+//
+// Complexity:
+//
+//	Time - O(n)
+//	Space - O(1)
+//
+// Example:
+//
+//	myList := linkedlist.New[int]()
+//	myList.AddLast(100) // myList: 100
+//	myList.AddLast(102) // myList: 100 -> 102
+//	for index, item := range myList.All() {
+//		fmt.Println(index, item) // 0 100, 1 102
+//	}
+func (l linkedList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		index := 0
+		for node := l.first; node != nil; node = node.next {
+			if !yield(index, node.value) {
+				return
+			}
+			index++
+		}
+	}
+}
+
+// Values returns an iterator over the values of the linked list from the beginning to end.
+//
+// Complexity:
+//
+//	Time - O(n)
+//	Space - O(1)
+//
+// Example:
+//
+//	myList := linkedlist.New[int]()
+//	myList.AddLast(100) // myList: 100
+//	myList.AddLast(102) // myList: 100 -> 102
+//	slices.Collect(myList.Values()) // [100 102]
+func (l linkedList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for node := l.first; node != nil; node = node.next {
+			if !yield(node.value) {
+				return
+			}
+		}
 	}
 }
 
@@ -381,10 +508,10 @@ func (l linkedList[T]) Traversal(traversal_func func(item T, index int)) {
 //	myList.InsertAt(102, 1) // myList: 102 -> 100
 //	myList.ToSlice() // myList:  [102 100]
 func (l linkedList[T]) ToSlice() []T {
-	values := []T{}
-	l.Traversal(func(item T, index int) {
+	values := make([]T, 0, l.size)
+	for item := range l.Values() {
 		values = append(values, item)
-	})
+	}
 	return values
 }
 
@@ -408,15 +535,15 @@ func (l linkedList[T]) Size() int {
 	return l.size
 }
 
-// Check the given item exist or not in the linkedlist.
+// Check an item the given function matches exist or not in the linkedlist.
 //
 // Parameters:
 //
-//	item: A targeted item to check its existence
+//	match: A function that reports whether an element is the targeted one
 //
 // Returns:
 //
-//	bool: If targeted item exist return true otherwise false.
+//	bool: If a matching item exist return true otherwise false.
 //
 // Complexity:
 //
@@ -424,20 +551,44 @@ func (l linkedList[T]) Size() int {
 //
 // Example:
 //
-//	myList := linkedlist.New[int]()
-//	myList.InsertAt(100, 0) // myList: 100
-//	myList.InsertAt(102, 1) // myList: 102 -> 100
-//	myList.Contains(102) // true
-//	myList.Contains(103) // false
-func (l linkedList[T]) Contains(item T) bool {
+//	myList := linkedlist.New[[]int]()
+//	myList.AddLast([]int{1, 2})
+//	myList.ContainsFunc(func(item []int) bool { return slices.Equal(item, []int{1, 2}) }) // true
+func (l linkedList[T]) ContainsFunc(match func(item T) bool) bool {
 	for node := l.first; node != nil; node = node.next {
-		if node.value == item {
+		if match(node.value) {
 			return true
 		}
 	}
 	return false
 }
 
+// Check the given item exist or not in a linkedlist of comparable elements.
+//
+// Parameters:
+//
+//	list: The linked list to search
+//	item: A targeted item to check its existence
+//
+// Returns:
+//
+//	bool: If targeted item exist return true otherwise false.
+//
+// Complexity:
+//
+//	Time - O(n)
+//
+// Example:
+//
+//	myList := linkedlist.New[int]()
+//	myList.InsertAt(100, 0) // myList: 100
+//	myList.InsertAt(102, 1) // myList: 102 -> 100
+//	linkedlist.Contains[int](&myList, 102) // true
+//	linkedlist.Contains[int](&myList, 103) // false
+func Contains[T comparable](list LinkedList[T], item T) bool {
+	return list.ContainsFunc(func(value T) bool { return value == item })
+}
+
 // Get the first item of the linkedlist.
 //
 // Returns:
@@ -456,7 +607,7 @@ func (l linkedList[T]) Contains(item T) bool {
 //	myList.First() // 102
 func (l linkedList[T]) First() (T, error) {
 	if l.last == nil {
-		return l._nil, errors.New(empty_list)
+		return l._nil, ErrEmpty
 	}
 
 	return l.first.value, nil
@@ -480,12 +631,83 @@ func (l linkedList[T]) First() (T, error) {
 //	myList.Last() // 100
 func (l linkedList[T]) Last() (T, error) {
 	if l.last == nil {
-		return l._nil, errors.New(empty_list)
+		return l._nil, ErrEmpty
 	}
 
 	return l.last.value, nil
 }
 
+// Clone creates a copy of the linked list with its own nodes, so changing one list does not affect the other.
+//
+// Complexity:
+//
+//	Time - O(n)
+//	Space - O(n)
+//
+// Example:
+//
+//	myList := linkedlist.Of(1, 2)
+//	copied := myList.Clone()
+//	copied.AddLast(3) // copied: 1 -> 2 -> 3, myList: 1 -> 2
+func (l linkedList[T]) Clone() linkedList[T] {
+	clone := New[T]()
+	for node := l.first; node != nil; node = node.next {
+		clone.AddLast(node.value)
+	}
+	return clone
+}
+
+// EqualFunc reports whether the other LinkedList holds the same elements in the same order, comparing the elements with the given function.
+//
+// Complexity:
+//
+//	Time - O(1) (Best Case)
+//	Time - O(n) (Worst Case)
+//
+// Example:
+//
+//	myList := linkedlist.Of([]byte("Omar"))
+//	other := linkedlist.DoublyOf([]byte("Omar"))
+//	myList.EqualFunc(&other, bytes.Equal) // true
+func (l linkedList[T]) EqualFunc(other LinkedList[T], equal func(a, b T) bool) bool {
+	if l.size != other.Size() {
+		return false
+	}
+	node := l.first
+	for item := range other.Values() {
+		if node == nil || !equal(node.value, item) {
+			return false
+		}
+		node = node.next
+	}
+	return true
+}
+
+// Equal reports whether two linked lists of comparable elements hold the same elements in the same order.
+//
+// Complexity:
+//
+//	Time - O(1) (Best Case)
+//	Time - O(n) (Worst Case)
+//
+// Example:
+//
+//	myList := linkedlist.Of(1, 2)
+//	other := linkedlist.DoublyOf(1, 2)
+//	linkedlist.Equal[int](&myList, &other) // true
+func Equal[T comparable](list LinkedList[T], other LinkedList[T]) bool {
+	return list.EqualFunc(other, func(a, b T) bool { return a == b })
+}
+
+// nodeAt walks to the node at the given index. The index must be in range.
+func (l *linkedList[T]) nodeAt(index int) *node[T] {
+	node := l.first
+	for i := 0; i < index; i++ {
+		node = node.next
+	}
+	return node
+}
+
 func (l linkedList[T]) isEmpty() bool {
 	return l.size == 0
 }
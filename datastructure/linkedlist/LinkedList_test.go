@@ -1,8 +1,12 @@
 package linkedlist
 
 import (
+	"bytes"
+	"fmt"
+	"slices"
 	"testing"
 
+	"github.com/OmarFaruk-0x01/go_algorithms/datastructure/errs"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -11,163 +15,602 @@ type Person struct {
 	age  uint
 }
 
-func TestFindIndex(t *testing.T) {
-	str := New[string]()
-
-	str.AddFirst("Sadik")
-	str.AddFirst("Omar")
-	str.AddFirst("Faruk")
-	str.RemoveAt(1)
+// implementations names every LinkedList implementation, each test runs once against all of them.
+var implementations = []string{"singly", "doubly"}
 
-	assert.Equal(t, 1, str.FindIndex("Sadik"))
-	assert.Equal(t, -1, str.FindIndex("Ahmad"))
-	assert.Equal(t, 0, str.FindIndex("Faruk"))
+func newList[T any](implementation string) LinkedList[T] {
+	if implementation == "doubly" {
+		list := NewDoubly[T]()
+		return &list
+	}
+	list := New[T]()
+	return &list
 }
 
-func TestAddFirst(t *testing.T) {
-	list := New[int]()
-	list.AddFirst(10)
-	list.AddFirst(20)
-	list.AddFirst(30)
-	f, _ := list.First()
-
-	assert.Equal(t, 30, f)
-
-	str := New[string]()
+func forEachImplementation(t *testing.T, test func(t *testing.T, implementation string)) {
+	for _, implementation := range implementations {
+		t.Run(implementation, func(t *testing.T) {
+			test(t, implementation)
+		})
+	}
+}
 
-	_, err := str.First()
-	assert.NotNil(t, err)
+func TestFindIndex(t *testing.T) {
+	forEachImplementation(t, func(t *testing.T, implementation string) {
+		str := newList[string](implementation)
 
-	str.AddFirst("Mango")
-	str.AddFirst("Banana")
-	str.AddFirst("Coconut")
-	sf, _ := str.First()
-	assert.Equal(t, "Coconut", sf)
+		str.AddFirst("Sadik")
+		str.AddFirst("Omar")
+		str.AddFirst("Faruk")
+		str.RemoveAt(1)
 
-	person := New[Person]()
-	_, err = person.First()
+		assert.Equal(t, 1, FindIndex(str, "Sadik"))
+		assert.Equal(t, -1, FindIndex(str, "Ahmad"))
+		assert.Equal(t, 0, FindIndex(str, "Faruk"))
+	})
+}
 
-	assert.NotNil(t, err)
-	person.AddFirst(Person{name: "Omar Faruk", age: 20})
-	person.AddFirst(Person{name: "Tanvir Raj", age: 25})
-	p1, _ := person.First()
-	assert.Equal(t, Person{name: "Tanvir Raj", age: 25}, p1)
-	assert.Equal(t, uint(25), p1.age)
-	assert.Equal(t, "Tanvir Raj", p1.name)
+func TestAddFirst(t *testing.T) {
+	forEachImplementation(t, func(t *testing.T, implementation string) {
+		list := newList[int](implementation)
+		list.AddFirst(10)
+		list.AddFirst(20)
+		list.AddFirst(30)
+		f, _ := list.First()
+
+		assert.Equal(t, 30, f)
+
+		str := newList[string](implementation)
+
+		_, err := str.First()
+		assert.NotNil(t, err)
+
+		str.AddFirst("Mango")
+		str.AddFirst("Banana")
+		str.AddFirst("Coconut")
+		sf, _ := str.First()
+		assert.Equal(t, "Coconut", sf)
+
+		person := newList[Person](implementation)
+		_, err = person.First()
+
+		assert.NotNil(t, err)
+		person.AddFirst(Person{name: "Omar Faruk", age: 20})
+		person.AddFirst(Person{name: "Tanvir Raj", age: 25})
+		p1, _ := person.First()
+		assert.Equal(t, Person{name: "Tanvir Raj", age: 25}, p1)
+		assert.Equal(t, uint(25), p1.age)
+		assert.Equal(t, "Tanvir Raj", p1.name)
+	})
 }
 
 func TestAddLast(t *testing.T) {
-	list := New[int]()
-	_, err := list.Last()
+	forEachImplementation(t, func(t *testing.T, implementation string) {
+		list := newList[int](implementation)
+		_, err := list.Last()
 
-	assert.NotNil(t, err)
+		assert.NotNil(t, err)
 
-	list.AddLast(30)
-	list.AddLast(20)
-	list.AddLast(10)
+		list.AddLast(30)
+		list.AddLast(20)
+		list.AddLast(10)
 
-	l, err := list.Last()
-	assert.Nil(t, err)
-	assert.Equal(t, 10, l)
+		l, err := list.Last()
+		assert.Nil(t, err)
+		assert.Equal(t, 10, l)
 
-	str := New[string]()
-	_, err = str.Last()
-	assert.NotNil(t, err)
+		str := newList[string](implementation)
+		_, err = str.Last()
+		assert.NotNil(t, err)
 
-	str.AddLast("Coconut")
-	str.AddLast("Mango")
-	str.AddLast("Banana")
+		str.AddLast("Coconut")
+		str.AddLast("Mango")
+		str.AddLast("Banana")
 
-	ls, err := str.Last()
-	assert.Nil(t, err)
-	assert.Equal(t, "Banana", ls)
+		ls, err := str.Last()
+		assert.Nil(t, err)
+		assert.Equal(t, "Banana", ls)
 
-	person := New[Person]()
-	_, err = person.Last()
-	assert.Panics(t, func() { panic(err) })
+		person := newList[Person](implementation)
+		_, err = person.Last()
+		assert.Panics(t, func() { panic(err) })
 
-	person.AddLast(Person{name: "Tanvir Raj", age: 25})
-	person.AddLast(Person{name: "Omar Faruk", age: 20})
+		person.AddLast(Person{name: "Tanvir Raj", age: 25})
+		person.AddLast(Person{name: "Omar Faruk", age: 20})
 
-	p1, err := person.Last()
-	assert.Nil(t, err)
-	assert.Equal(t, Person{name: "Omar Faruk", age: 20}, p1)
-	assert.Equal(t, uint(20), p1.age)
-	assert.Equal(t, "Omar Faruk", p1.name)
+		p1, err := person.Last()
+		assert.Nil(t, err)
+		assert.Equal(t, Person{name: "Omar Faruk", age: 20}, p1)
+		assert.Equal(t, uint(20), p1.age)
+		assert.Equal(t, "Omar Faruk", p1.name)
+	})
 }
 
 func TestInsertAt(t *testing.T) {
-	list := New[int]()
-	list.InsertAt(30, 0)
-	list.InsertAt(20, 1)
-	list.InsertAt(10, 2)
-	assert.Equal(t, 1, list.FindIndex(20))
-
-	str := New[string]()
-	str.InsertAt("Coconut", 0)
-	str.InsertAt("Banana", 1)
-	str.InsertAt("Mango", 1)
-	str.InsertAt("Lichi", 3)
-	str.InsertAt("Orange", str.Size())
-
-	last, err := str.Last()
-	assert.Nil(t, err)
-	assert.Equal(t, "Orange", last)
-	assert.Equal(t, 2, str.FindIndex("Banana"))
-	assert.Panics(t, func() {
-		str.InsertAt("Orange", 6)
+	forEachImplementation(t, func(t *testing.T, implementation string) {
+		list := newList[int](implementation)
+		list.InsertAt(30, 0)
+		list.InsertAt(20, 1)
+		list.InsertAt(10, 2)
+		assert.Equal(t, 1, FindIndex(list, 20))
+
+		str := newList[string](implementation)
+		str.InsertAt("Coconut", 0)
+		str.InsertAt("Banana", 1)
+		str.InsertAt("Mango", 1)
+		str.InsertAt("Lichi", 3)
+		str.InsertAt("Orange", str.Size())
+
+		last, err := str.Last()
+		assert.Nil(t, err)
+		assert.Equal(t, "Orange", last)
+		assert.Equal(t, 2, FindIndex(str, "Banana"))
+		assert.Panics(t, func() {
+			str.InsertAt("Orange", 6)
+		})
+
+		person := newList[Person](implementation)
+		person.InsertAt(Person{name: "Tanvir Raj", age: 25}, 0)
+		person.InsertAt(Person{name: "Omar Faruk", age: 21}, 1)
+		person.InsertAt(Person{name: "Sadik Ahmad", age: 20}, 1)
+		p1, err := person.Last()
+		assert.Nil(t, err)
+		assert.Equal(t, Person{name: "Omar Faruk", age: 21}, p1)
+		assert.Equal(t, uint(21), p1.age)
+		assert.Equal(t, "Omar Faruk", p1.name)
 	})
-
-	person := New[Person]()
-	person.InsertAt(Person{name: "Tanvir Raj", age: 25}, 0)
-	person.InsertAt(Person{name: "Omar Faruk", age: 21}, 1)
-	person.InsertAt(Person{name: "Sadik Ahmad", age: 20}, 1)
-	p1, err := person.Last()
-	assert.Nil(t, err)
-	assert.Equal(t, Person{name: "Omar Faruk", age: 21}, p1)
-	assert.Equal(t, uint(21), p1.age)
-	assert.Equal(t, "Omar Faruk", p1.name)
 }
 
 func TestRemoveFirst(t *testing.T) {
-	str := New[string]()
+	forEachImplementation(t, func(t *testing.T, implementation string) {
+		str := newList[string](implementation)
 
-	err := str.RemoveAt(2)
-	assert.NotNil(t, err)
+		err := str.RemoveAt(2)
+		assert.NotNil(t, err)
 
-	str.AddFirst("Omar")
-	str.AddFirst("Faruk")
-	str.RemoveFirst()
+		str.AddFirst("Omar")
+		str.AddFirst("Faruk")
+		str.RemoveFirst()
 
-	s, err := str.First()
-	assert.Nil(t, err)
+		s, err := str.First()
+		assert.Nil(t, err)
 
-	assert.Equal(t, "Omar", s)
+		assert.Equal(t, "Omar", s)
+	})
 }
 
 func TestRemoveLast(t *testing.T) {
-	str := New[string]()
-	err := str.RemoveAt(2)
-	assert.NotNil(t, err)
-	str.AddFirst("Omar")
-	str.AddFirst("Faruk")
-	str.RemoveLast()
-	s, err := str.Last()
-	assert.Nil(t, err)
+	forEachImplementation(t, func(t *testing.T, implementation string) {
+		str := newList[string](implementation)
+		err := str.RemoveAt(2)
+		assert.NotNil(t, err)
+		str.AddFirst("Omar")
+		str.AddFirst("Faruk")
+		str.RemoveLast()
+		s, err := str.Last()
+		assert.Nil(t, err)
+
+		assert.Equal(t, "Faruk", s)
+	})
+}
 
-	assert.Equal(t, "Faruk", s)
+func TestRemoveOnlyItem(t *testing.T) {
+	forEachImplementation(t, func(t *testing.T, implementation string) {
+		str := newList[string](implementation)
+		str.AddFirst("Omar")
+		assert.Nil(t, str.RemoveLast())
+		_, err := str.Last()
+		assert.NotNil(t, err)
+		str.AddLast("Faruk")
+		s, err := str.First()
+		assert.Nil(t, err)
+		assert.Equal(t, "Faruk", s)
+
+		assert.Nil(t, str.RemoveFirst())
+		_, err = str.Last()
+		assert.NotNil(t, err)
+		str.AddFirst("Sadik")
+		s, err = str.Last()
+		assert.Nil(t, err)
+		assert.Equal(t, "Sadik", s)
+	})
 }
 
 func TestRemoveAt(t *testing.T) {
-	str := New[string]()
-	err := str.RemoveAt(2)
-	assert.NotNil(t, err)
-	str.AddFirst("Sadik")
-	str.AddFirst("Faruk")
-	str.AddFirst("Omar")
-	str.RemoveAt(1)
-	s, err := str.Last()
-	assert.Nil(t, err)
-
-	assert.Equal(t, "Sadik", s)
+	forEachImplementation(t, func(t *testing.T, implementation string) {
+		str := newList[string](implementation)
+		err := str.RemoveAt(2)
+		assert.NotNil(t, err)
+		str.AddFirst("Sadik")
+		str.AddFirst("Faruk")
+		str.AddFirst("Omar")
+		str.RemoveAt(1)
+		s, err := str.Last()
+		assert.Nil(t, err)
+
+		assert.Equal(t, "Sadik", s)
+	})
+}
+
+func TestAll(t *testing.T) {
+	forEachImplementation(t, func(t *testing.T, implementation string) {
+		list := newList[string](implementation)
+		list.AddLast("Omar")
+		list.AddLast("Faruk")
+		list.AddLast("Sadik")
+
+		indexes := []int{}
+		items := []string{}
+		for index, item := range list.All() {
+			if item == "Sadik" {
+				break
+			}
+			indexes = append(indexes, index)
+			items = append(items, item)
+		}
+		assert.Equal(t, []int{0, 1}, indexes)
+		assert.Equal(t, []string{"Omar", "Faruk"}, items)
+		assert.Equal(t, []string{"Omar", "Faruk", "Sadik"}, slices.Collect(list.Values()))
+
+		empty := newList[int](implementation)
+		assert.Empty(t, slices.Collect(empty.Values()))
+	})
+}
+
+func TestFromSlice(t *testing.T) {
+	items := []string{"Omar", "Faruk", "Sadik"}
+	list := FromSlice(items)
+	doubly := DoublyFromSlice(items)
+	items[0] = "Ahmad"
+
+	assert.Equal(t, []string{"Omar", "Faruk", "Sadik"}, list.ToSlice())
+	assert.Equal(t, []string{"Omar", "Faruk", "Sadik"}, doubly.ToSlice())
+	assert.Equal(t, 3, list.Size())
+	last, _ := doubly.Last()
+	assert.Equal(t, "Sadik", last)
+
+	empty := Of[int]()
+	assert.Equal(t, 0, empty.Size())
+	person := DoublyOf(Person{name: "Omar Faruk", age: 20}, Person{name: "Tanvir Raj", age: 25})
+	assert.Equal(t, 2, person.Size())
+}
+
+func TestClone(t *testing.T) {
+	list := Of(1, 2, 3)
+	clone := list.Clone()
+	clone.AddLast(4)
+	clone.RemoveFirst()
+	assert.Equal(t, []int{1, 2, 3}, list.ToSlice())
+	assert.Equal(t, []int{2, 3, 4}, clone.ToSlice())
+
+	doubly := DoublyOf(1, 2, 3)
+	doublyClone := doubly.Clone()
+	doublyClone.RemoveLast()
+	doublyClone.AddFirst(0)
+	assert.Equal(t, []int{1, 2, 3}, doubly.ToSlice())
+	assert.Equal(t, []int{0, 1, 2}, doublyClone.ToSlice())
+
+	empty := New[int]()
+	emptyClone := empty.Clone()
+	emptyClone.AddLast(1)
+	assert.Equal(t, 0, empty.Size())
+}
+
+func TestEqual(t *testing.T) {
+	list := Of(1, 2, 3)
+	doubly := DoublyOf(1, 2, 3)
+	assert.True(t, Equal[int](&list, &doubly))
+	assert.True(t, Equal[int](&doubly, &list))
+	assert.True(t, Equal[int](&list, &list))
+
+	doubly.RemoveLast()
+	assert.False(t, Equal[int](&list, &doubly))
+	doubly.AddLast(4)
+	assert.False(t, Equal[int](&list, &doubly))
+	assert.False(t, Equal[int](&doubly, &list))
+
+	empty := New[int]()
+	emptyDoubly := NewDoubly[int]()
+	assert.True(t, Equal[int](&empty, &emptyDoubly))
+}
+
+func TestErrors(t *testing.T) {
+	forEachImplementation(t, func(t *testing.T, implementation string) {
+		list := newList[int](implementation)
+		_, err := list.First()
+		assert.ErrorIs(t, err, ErrEmpty)
+		_, err = list.Last()
+		assert.ErrorIs(t, err, ErrEmpty)
+		assert.ErrorIs(t, list.RemoveFirst(), ErrEmpty)
+		assert.ErrorIs(t, list.RemoveLast(), ErrEmpty)
+		assert.ErrorIs(t, list.RemoveAt(0), ErrEmpty)
+
+		list.AddLast(1)
+		list.AddLast(2)
+		for _, index := range []int{-1, 2, 5} {
+			err = list.RemoveAt(index)
+			assert.ErrorIs(t, err, ErrIndexOutOfRange)
+			var indexErr *errs.IndexOutOfRangeError
+			assert.ErrorAs(t, err, &indexErr)
+			assert.Equal(t, index, indexErr.Index)
+			assert.Equal(t, 2, indexErr.Size)
+		}
+
+		_, err = FindFirst(list, func(item int) bool { return item > 2 })
+		assert.ErrorIs(t, err, ErrNotFound)
+		_, err = list.(CursorList[int]).Cursor().Value()
+		assert.ErrorIs(t, err, ErrNoCurrentItem)
+		assert.ErrorIs(t, list.(CursorList[int]).Cursor().Remove(), ErrNoCurrentItem)
+	})
+}
+
+func TestAnyElementType(t *testing.T) {
+	forEachImplementation(t, func(t *testing.T, implementation string) {
+		payloads := newList[[]byte](implementation)
+		payloads.AddLast([]byte("Omar"))
+		payloads.AddLast([]byte("Faruk"))
+		payloads.AddFirst(nil)
+
+		isFaruk := func(item []byte) bool { return bytes.Equal(item, []byte("Faruk")) }
+		assert.Equal(t, 2, payloads.IndexFunc(isFaruk))
+		assert.True(t, payloads.ContainsFunc(isFaruk))
+		assert.Equal(t, -1, payloads.IndexFunc(func(item []byte) bool { return bytes.Equal(item, []byte("Sadik")) }))
+		assert.False(t, payloads.ContainsFunc(func(item []byte) bool { return len(item) > 5 }))
+
+		other := newList[[]byte](implementation)
+		other.AddLast(nil)
+		other.AddLast([]byte("Omar"))
+		other.AddLast([]byte("Faruk"))
+		assert.True(t, payloads.EqualFunc(other, bytes.Equal))
+		other.RemoveLast()
+		other.AddLast([]byte("Sadik"))
+		assert.False(t, payloads.EqualFunc(other, bytes.Equal))
+
+		handlers := newList[func() int](implementation)
+		handlers.AddLast(func() int { return 1 })
+		handlers.AddLast(func() int { return 2 })
+		last, _ := handlers.Last()
+		assert.Equal(t, 2, last())
+	})
+}
+
+func TestContains(t *testing.T) {
+	forEachImplementation(t, func(t *testing.T, implementation string) {
+		list := newList[int](implementation)
+		assert.False(t, Contains(list, 100))
+		list.AddLast(100)
+		list.AddLast(102)
+		assert.True(t, Contains(list, 102))
+		assert.False(t, Contains(list, 103))
+	})
+}
+
+// benchmarkSizes are the list sizes every method is measured at, so the growth of the timings shows the complexity of the method.
+var benchmarkSizes = []int{10, 100, 1000, 10000}
+
+// BenchmarkLinkedList measures every method of both lists at every benchmark size. The benchmarks that change the list undo the change with the cheapest opposite operation, so the list keeps its size across iterations and the timing is the sum of both.
+func BenchmarkLinkedList(b *testing.B) {
+	notFound := func(item int) bool { return item < 0 }
+	methods := []struct {
+		name string
+		run  func(b *testing.B, list LinkedList[int], size int)
+	}{
+		{"AddFirst", func(b *testing.B, list LinkedList[int], size int) {
+			for i := 0; i < b.N; i++ {
+				list.AddFirst(i)
+				list.RemoveFirst()
+			}
+		}},
+		{"AddLast", func(b *testing.B, list LinkedList[int], size int) {
+			for i := 0; i < b.N; i++ {
+				list.AddLast(i)
+				list.RemoveFirst()
+			}
+		}},
+		{"InsertAt", func(b *testing.B, list LinkedList[int], size int) {
+			for i := 0; i < b.N; i++ {
+				list.InsertAt(i, size/2)
+				list.RemoveFirst()
+			}
+		}},
+		{"RemoveFirst", func(b *testing.B, list LinkedList[int], size int) {
+			for i := 0; i < b.N; i++ {
+				list.RemoveFirst()
+				list.AddLast(i)
+			}
+		}},
+		{"RemoveLast", func(b *testing.B, list LinkedList[int], size int) {
+			for i := 0; i < b.N; i++ {
+				list.RemoveLast()
+				list.AddFirst(i)
+			}
+		}},
+		{"RemoveAt", func(b *testing.B, list LinkedList[int], size int) {
+			for i := 0; i < b.N; i++ {
+				list.RemoveAt(size / 2)
+				list.AddFirst(i)
+			}
+		}},
+		{"First", func(b *testing.B, list LinkedList[int], size int) {
+			for i := 0; i < b.N; i++ {
+				list.First()
+			}
+		}},
+		{"Last", func(b *testing.B, list LinkedList[int], size int) {
+			for i := 0; i < b.N; i++ {
+				list.Last()
+			}
+		}},
+		{"Size", func(b *testing.B, list LinkedList[int], size int) {
+			for i := 0; i < b.N; i++ {
+				list.Size()
+			}
+		}},
+		{"IndexFunc", func(b *testing.B, list LinkedList[int], size int) {
+			for i := 0; i < b.N; i++ {
+				list.IndexFunc(notFound)
+			}
+		}},
+		{"ContainsFunc", func(b *testing.B, list LinkedList[int], size int) {
+			for i := 0; i < b.N; i++ {
+				list.ContainsFunc(notFound)
+			}
+		}},
+		{"EqualFunc", func(b *testing.B, list LinkedList[int], size int) {
+			other := newList[int]("singly")
+			for item := range list.Values() {
+				other.AddLast(item)
+			}
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				list.EqualFunc(other, func(a, b int) bool { return a == b })
+			}
+		}},
+		{"ToSlice", func(b *testing.B, list LinkedList[int], size int) {
+			for i := 0; i < b.N; i++ {
+				list.ToSlice()
+			}
+		}},
+		{"Traversal", func(b *testing.B, list LinkedList[int], size int) {
+			for i := 0; i < b.N; i++ {
+				list.Traversal(func(item int, index int) {})
+			}
+		}},
+		{"All", func(b *testing.B, list LinkedList[int], size int) {
+			for i := 0; i < b.N; i++ {
+				for range list.All() {
+				}
+			}
+		}},
+		{"Values", func(b *testing.B, list LinkedList[int], size int) {
+			for i := 0; i < b.N; i++ {
+				for range list.Values() {
+				}
+			}
+		}},
+		{"Cursor", func(b *testing.B, list LinkedList[int], size int) {
+			for i := 0; i < b.N; i++ {
+				for cursor := list.(CursorList[int]).Cursor(); cursor.Next(); {
+				}
+			}
+		}},
+		{"SortFunc", func(b *testing.B, list LinkedList[int], size int) {
+			ascending := func(a, b int) int { return a - b }
+			descending := func(a, b int) int { return b - a }
+			for i := 0; i < b.N; i++ {
+				// Alternate the order so every iteration sorts a list that is not sorted yet.
+				if i%2 == 0 {
+					SortFunc(list, ascending)
+				} else {
+					SortFunc(list, descending)
+				}
+			}
+		}},
+		{"InsertSortedFunc", func(b *testing.B, list LinkedList[int], size int) {
+			SortFunc(list, func(a, b int) int { return a - b })
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				InsertSortedFunc(list, size/2, func(a, b int) int { return a - b })
+				list.RemoveFirst()
+			}
+		}},
+	}
+	for _, implementation := range implementations {
+		for _, method := range methods {
+			for _, size := range benchmarkSizes {
+				b.Run(fmt.Sprintf("%s/%s/size=%d", implementation, method.name, size), func(b *testing.B) {
+					list := newList[int](implementation)
+					for i := 0; i < size; i++ {
+						list.AddLast(size - i)
+					}
+					b.ReportAllocs()
+					b.ResetTimer()
+					method.run(b, list, size)
+				})
+			}
+		}
+	}
+}
+
+// structural is the method set both lists add to LinkedList, L being the list type itself.
+type structural[L any] interface {
+	*L
+	LinkedList[int]
+	Reverse()
+	Rotate(k int)
+	Concat(other *L)
+	Splice(index int, other *L) error
+	Split(index int) (L, error)
+	Clone() L
+	String() string
+}
+
+// BenchmarkLinkedListStructural measures the methods both lists have beyond LinkedList at every benchmark size.
+func BenchmarkLinkedListStructural(b *testing.B) {
+	benchmarkStructural(b, "singly", New[int])
+	benchmarkStructural(b, "doubly", NewDoubly[int])
+}
+
+// benchmarkStructural runs the structural benchmarks for one list type. Concat, Splice and Split are paired with the operation undoing them, so the list keeps its size across iterations.
+func benchmarkStructural[L any, P structural[L]](b *testing.B, implementation string, newList func() L) {
+	methods := []struct {
+		name string
+		run  func(b *testing.B, list P, size int)
+	}{
+		{"Reverse", func(b *testing.B, list P, size int) {
+			for i := 0; i < b.N; i++ {
+				list.Reverse()
+			}
+		}},
+		{"Rotate", func(b *testing.B, list P, size int) {
+			for i := 0; i < b.N; i++ {
+				list.Rotate(size / 2)
+			}
+		}},
+		{"Concat", func(b *testing.B, list P, size int) {
+			other := newList()
+			P(&other).AddLast(0)
+			for i := 0; i < b.N; i++ {
+				list.Concat(&other)
+				other, _ = list.Split(size)
+			}
+		}},
+		{"Splice", func(b *testing.B, list P, size int) {
+			other := newList()
+			for i := 0; i < b.N; i++ {
+				P(&other).AddLast(i)
+				list.Splice(size/2, &other)
+				list.RemoveAt(size / 2)
+			}
+		}},
+		{"Split", func(b *testing.B, list P, size int) {
+			for i := 0; i < b.N; i++ {
+				tail, _ := list.Split(size / 2)
+				list.Concat(&tail)
+			}
+		}},
+		{"Clone", func(b *testing.B, list P, size int) {
+			for i := 0; i < b.N; i++ {
+				list.Clone()
+			}
+		}},
+		{"String", func(b *testing.B, list P, size int) {
+			for i := 0; i < b.N; i++ {
+				_ = list.String()
+			}
+		}},
+	}
+	for _, method := range methods {
+		for _, size := range benchmarkSizes {
+			b.Run(fmt.Sprintf("%s/%s/size=%d", implementation, method.name, size), func(b *testing.B) {
+				list := newList()
+				for i := 0; i < size; i++ {
+					P(&list).AddLast(size - i)
+				}
+				b.ReportAllocs()
+				b.ResetTimer()
+				method.run(b, &list, size)
+			})
+		}
+	}
 }
@@ -0,0 +1,260 @@
+package linkedlist
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Sort sorts the linked list in ascending order. The sort is stable and happens in place by relinking the nodes.
+//
+// Complexity:
+//
+//	Time - O(n log n)
+//	Space - O(1)
+//
+// Example:
+//
+//	myList := linkedlist.New[int]()
+//	myList.AddLast(3)
+//	myList.AddLast(1)
+//	myList.AddLast(2)
+//	linkedlist.Sort[int](&myList) // myList: 1 -> 2 -> 3
+func Sort[T cmp.Ordered](list LinkedList[T]) {
+	SortFunc(list, cmp.Compare[T])
+}
+
+// InsertSorted adds an element to a linked list that is sorted in ascending order and keeps it sorted. The new element is placed after the elements that are equal to it.
+//
+// Complexity:
+//
+//	Time - O(1) (Best Case)
+//	Time - O(n) (Worst Case)
+//	Space - O(1)
+//
+// Example:
+//
+//	myList := linkedlist.New[int]()
+//	linkedlist.InsertSorted[int](&myList, 3) // myList: 3
+//	linkedlist.InsertSorted[int](&myList, 1) // myList: 1 -> 3
+//	linkedlist.InsertSorted[int](&myList, 2) // myList: 1 -> 2 -> 3
+func InsertSorted[T cmp.Ordered](list LinkedList[T], item T) {
+	InsertSortedFunc(list, item, cmp.Compare[T])
+}
+
+// Sorter is a LinkedList that sorts itself in place. Both lists of this package implement it. It is kept out of LinkedList so that implementations outside of this package do not have to provide it, SortFunc and InsertSortedFunc work on those as well.
+type Sorter[T any] interface {
+	LinkedList[T]
+	SortFunc(compare func(a, b T) int)
+	InsertSortedFunc(item T, compare func(a, b T) int)
+}
+
+// SortFunc sorts the linked list using the given comparison function. The sort is stable. A Sorter sorts itself in place, any other list is copied into a slice, sorted and refilled.
+//
+// Complexity:
+//
+//	Time - O(n log n)
+//	Space - O(1) (Sorter)
+//	Space - O(n) (Other lists)
+//
+// Example:
+//
+//	person := linkedlist.New[Person]()
+//	person.AddLast(Person{name: "Tanvir Raj", age: 25})
+//	person.AddLast(Person{name: "Omar Faruk", age: 20})
+//	linkedlist.SortFunc[Person](&person, func(a, b Person) int { return cmp.Compare(a.age, b.age) }) // person: Omar Faruk -> Tanvir Raj
+func SortFunc[T any](list LinkedList[T], compare func(a, b T) int) {
+	if sorter, ok := list.(Sorter[T]); ok {
+		sorter.SortFunc(compare)
+		return
+	}
+	items := list.ToSlice()
+	slices.SortStableFunc(items, compare)
+	for list.Size() > 0 {
+		list.RemoveFirst()
+	}
+	for _, item := range items {
+		list.AddLast(item)
+	}
+}
+
+// InsertSortedFunc adds an element to a linked list that is sorted by the given comparison function and keeps it sorted. The new element is placed after the elements that are equal to it. A Sorter inserts it with its own method, any other list through IndexFunc and InsertAt.
+//
+// Complexity:
+//
+//	Time - O(1) (Best Case)
+//	Time - O(n) (Worst Case)
+//	Space - O(1)
+func InsertSortedFunc[T any](list LinkedList[T], item T, compare func(a, b T) int) {
+	if sorter, ok := list.(Sorter[T]); ok {
+		sorter.InsertSortedFunc(item, compare)
+		return
+	}
+	index := list.IndexFunc(func(other T) bool { return compare(item, other) < 0 })
+	if index == -1 {
+		list.AddLast(item)
+		return
+	}
+	list.InsertAt(item, index)
+}
+
+// SortFunc sorts the linked list with a bottom-up merge sort using the given comparison function. The comparison must return a negative number when a < b, a positive number when a > b and zero when they are equal. The sort is stable and happens in place by relinking the nodes.
+//
+// Parameters:
+//
+//	compare: A function that compares two elements.
+//
+// Complexity:
+//
+//	Time - O(n log n)
+//	Space - O(1)
+//
+// Example:
+//
+//	person := linkedlist.New[Person]()
+//	person.AddLast(Person{name: "Tanvir Raj", age: 25})
+//	person.AddLast(Person{name: "Omar Faruk", age: 20})
+//	person.SortFunc(func(a, b Person) int { return cmp.Compare(a.age, b.age) }) // person: Omar Faruk -> Tanvir Raj
+func (l *linkedList[T]) SortFunc(compare func(a, b T) int) {
+	if l.size < 2 {
+		return
+	}
+	for width := 1; ; width *= 2 {
+		var head, tail *node[T]
+		merges := 0
+		left := l.first
+		for left != nil {
+			merges++
+			right := left
+			leftSize := 0
+			for leftSize < width && right != nil {
+				leftSize++
+				right = right.next
+			}
+			rightSize := width
+			for leftSize > 0 || (rightSize > 0 && right != nil) {
+				var next *node[T]
+				if leftSize == 0 || (rightSize > 0 && right != nil && compare(right.value, left.value) < 0) {
+					next, right = right, right.next
+					rightSize--
+				} else {
+					next, left = left, left.next
+					leftSize--
+				}
+				if tail == nil {
+					head = next
+				} else {
+					tail.next = next
+				}
+				tail = next
+			}
+			left = right
+		}
+		tail.next = nil
+		l.first = head
+		l.last = tail
+		if merges == 1 {
+			return
+		}
+	}
+}
+
+// InsertSortedFunc adds an element to a linked list that is sorted by the given comparison function and keeps it sorted. The new element is placed after the elements that are equal to it.
+//
+// Complexity:
+//
+//	Time - O(1) (Best Case)
+//	Time - O(n) (Worst Case)
+//	Space - O(1)
+func (l *linkedList[T]) InsertSortedFunc(item T, compare func(a, b T) int) {
+	if l.isEmpty() || compare(item, l.last.value) >= 0 {
+		l.AddLast(item)
+		return
+	}
+	if compare(item, l.first.value) < 0 {
+		l.AddFirst(item)
+		return
+	}
+	previousNode := l.first
+	for compare(item, previousNode.next.value) >= 0 {
+		previousNode = previousNode.next
+	}
+	previousNode.next = &node[T]{value: item, next: previousNode.next}
+	l.size++
+}
+
+// SortFunc sorts the linked list with a bottom-up merge sort using the given comparison function. The sort is stable and happens in place by relinking the nodes, the backward links are rebuilt while merging.
+//
+// Complexity:
+//
+//	Time - O(n log n)
+//	Space - O(1)
+func (l *doublyLinkedList[T]) SortFunc(compare func(a, b T) int) {
+	if l.size < 2 {
+		return
+	}
+	for width := 1; ; width *= 2 {
+		var head, tail *doublyNode[T]
+		merges := 0
+		left := l.first
+		for left != nil {
+			merges++
+			right := left
+			leftSize := 0
+			for leftSize < width && right != nil {
+				leftSize++
+				right = right.next
+			}
+			rightSize := width
+			for leftSize > 0 || (rightSize > 0 && right != nil) {
+				var next *doublyNode[T]
+				if leftSize == 0 || (rightSize > 0 && right != nil && compare(right.value, left.value) < 0) {
+					next, right = right, right.next
+					rightSize--
+				} else {
+					next, left = left, left.next
+					leftSize--
+				}
+				if tail == nil {
+					head = next
+				} else {
+					tail.next = next
+				}
+				next.prev = tail
+				tail = next
+			}
+			left = right
+		}
+		tail.next = nil
+		l.first = head
+		l.last = tail
+		if merges == 1 {
+			return
+		}
+	}
+}
+
+// InsertSortedFunc adds an element to a linked list that is sorted by the given comparison function and keeps it sorted. The new element is placed after the elements that are equal to it, searching from the end of the list.
+//
+// Complexity:
+//
+//	Time - O(1) (Best Case)
+//	Time - O(n) (Worst Case)
+//	Space - O(1)
+func (l *doublyLinkedList[T]) InsertSortedFunc(item T, compare func(a, b T) int) {
+	if l.isEmpty() || compare(item, l.last.value) >= 0 {
+		l.AddLast(item)
+		return
+	}
+	if compare(item, l.first.value) < 0 {
+		l.AddFirst(item)
+		return
+	}
+	nextNode := l.last
+	for compare(item, nextNode.prev.value) < 0 {
+		nextNode = nextNode.prev
+	}
+	newNode := &doublyNode[T]{value: item, prev: nextNode.prev, next: nextNode}
+	nextNode.prev.next = newNode
+	nextNode.prev = newNode
+	l.size++
+}
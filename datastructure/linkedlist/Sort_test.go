@@ -0,0 +1,139 @@
+package linkedlist
+
+import (
+	"cmp"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSort(t *testing.T) {
+	forEachImplementation(t, func(t *testing.T, implementation string) {
+		for _, size := range []int{0, 1, 2, 3, 7, 8, 100, 1000} {
+			list := newList[int](implementation)
+			expected := []int{}
+			for i := 0; i < size; i++ {
+				item := rand.Intn(50)
+				list.AddLast(item)
+				expected = append(expected, item)
+			}
+			slices.Sort(expected)
+
+			Sort(list)
+			assert.Equal(t, expected, list.ToSlice())
+			assert.Equal(t, size, list.Size())
+			if size > 0 {
+				first, _ := list.First()
+				last, _ := list.Last()
+				assert.Equal(t, expected[0], first)
+				assert.Equal(t, expected[size-1], last)
+			}
+
+			list.AddLast(-1)
+			list.AddFirst(100)
+			assert.Equal(t, size+2, list.Size())
+		}
+	})
+}
+
+func TestSortFuncStable(t *testing.T) {
+	forEachImplementation(t, func(t *testing.T, implementation string) {
+		person := newList[Person](implementation)
+		person.AddLast(Person{name: "Tanvir Raj", age: 25})
+		person.AddLast(Person{name: "Omar Faruk", age: 20})
+		person.AddLast(Person{name: "Sadik Ahmad", age: 25})
+		person.AddLast(Person{name: "Faruk", age: 20})
+
+		SortFunc(person, func(a, b Person) int { return cmp.Compare(a.age, b.age) })
+		assert.Equal(t, []Person{
+			{name: "Omar Faruk", age: 20},
+			{name: "Faruk", age: 20},
+			{name: "Tanvir Raj", age: 25},
+			{name: "Sadik Ahmad", age: 25},
+		}, person.ToSlice())
+
+		SortFunc(person, func(a, b Person) int { return cmp.Compare(b.age, a.age) })
+		names := []string{}
+		for p := range person.Values() {
+			names = append(names, p.name)
+		}
+		assert.Equal(t, []string{"Tanvir Raj", "Sadik Ahmad", "Omar Faruk", "Faruk"}, names)
+	})
+}
+
+func TestSortDoublyBackward(t *testing.T) {
+	list := NewDoubly[int]()
+	for _, item := range []int{5, 3, 9, 1, 7} {
+		list.AddLast(item)
+	}
+	Sort[int](&list)
+
+	items := []int{}
+	for _, item := range list.Backward() {
+		items = append(items, item)
+	}
+	assert.Equal(t, []int{9, 7, 5, 3, 1}, items)
+}
+
+func TestInsertSorted(t *testing.T) {
+	forEachImplementation(t, func(t *testing.T, implementation string) {
+		list := newList[int](implementation)
+		for _, item := range []int{5, 1, 9, 3, 3, 0, 10, 7} {
+			InsertSorted(list, item)
+		}
+		assert.Equal(t, []int{0, 1, 3, 3, 5, 7, 9, 10}, list.ToSlice())
+		assert.Equal(t, 8, list.Size())
+		last, _ := list.Last()
+		assert.Equal(t, 10, last)
+
+		person := newList[Person](implementation)
+		byAge := func(a, b Person) int { return cmp.Compare(a.age, b.age) }
+		InsertSortedFunc(person, Person{name: "Tanvir Raj", age: 25}, byAge)
+		InsertSortedFunc(person, Person{name: "Omar Faruk", age: 20}, byAge)
+		InsertSortedFunc(person, Person{name: "Sadik Ahmad", age: 20}, byAge)
+		assert.Equal(t, []Person{
+			{name: "Omar Faruk", age: 20},
+			{name: "Sadik Ahmad", age: 20},
+			{name: "Tanvir Raj", age: 25},
+		}, person.ToSlice())
+	})
+}
+
+var (
+	_ Sorter[int] = (*linkedList[int])(nil)
+	_ Sorter[int] = (*doublyLinkedList[int])(nil)
+)
+
+// plainList hides every method that is not part of LinkedList, like an implementation from outside of this package.
+type plainList[T any] struct {
+	LinkedList[T]
+}
+
+func TestSortFuncWithoutSorter(t *testing.T) {
+	forEachImplementation(t, func(t *testing.T, implementation string) {
+		var list LinkedList[Person] = plainList[Person]{newList[Person](implementation)}
+		_, isSorter := list.(Sorter[Person])
+		assert.False(t, isSorter)
+
+		byAge := func(a, b Person) int { return cmp.Compare(a.age, b.age) }
+		list.AddLast(Person{name: "Tanvir Raj", age: 25})
+		list.AddLast(Person{name: "Omar Faruk", age: 20})
+		list.AddLast(Person{name: "Sadik Ahmad", age: 25})
+		SortFunc(list, byAge)
+		InsertSortedFunc(list, Person{name: "Faruk", age: 20}, byAge)
+		InsertSortedFunc(list, Person{name: "Ahmad", age: 30}, byAge)
+		InsertSortedFunc(list, Person{name: "Raj", age: 10}, byAge)
+		assert.Equal(t, []Person{
+			{name: "Raj", age: 10},
+			{name: "Omar Faruk", age: 20},
+			{name: "Faruk", age: 20},
+			{name: "Tanvir Raj", age: 25},
+			{name: "Sadik Ahmad", age: 25},
+			{name: "Ahmad", age: 30},
+		}, list.ToSlice())
+		last, _ := list.Last()
+		assert.Equal(t, "Ahmad", last.name)
+	})
+}
@@ -0,0 +1,301 @@
+package linkedlist
+
+import "github.com/OmarFaruk-0x01/go_algorithms/datastructure/errs"
+
+// Reverse reverses the order of the elements of the linked list in place by relinking the nodes.
+//
+// Complexity:
+//
+//	Time - O(n)
+//	Space - O(1)
+//
+// Example:
+//
+//	myList := linkedlist.New[int]()
+//	myList.AddLast(1)
+//	myList.AddLast(2)
+//	myList.AddLast(3)
+//	myList.Reverse() // myList: 3 -> 2 -> 1
+func (l *linkedList[T]) Reverse() {
+	var previousNode *node[T]
+	for current := l.first; current != nil; {
+		nextNode := current.next
+		current.next = previousNode
+		previousNode = current
+		current = nextNode
+	}
+	l.first, l.last = l.last, l.first
+}
+
+// Concat moves every element of the other linked list to the end of this list. The other list is left empty. Concatenating a list with itself does nothing.
+//
+// Parameters:
+//
+//	other: The linked list whose nodes are appended.
+//
+// Complexity:
+//
+//	Time - O(1)
+//	Space - O(1)
+//
+// Example:
+//
+//	first := linkedlist.New[int]()
+//	first.AddLast(1)
+//	second := linkedlist.New[int]()
+//	second.AddLast(2)
+//	first.Concat(&second) // first: 1 -> 2, second: empty
+func (l *linkedList[T]) Concat(other *linkedList[T]) {
+	if other == l || other.isEmpty() {
+		return
+	}
+	if l.isEmpty() {
+		l.first = other.first
+	} else {
+		l.last.next = other.first
+	}
+	l.last = other.last
+	l.size += other.size
+	other.first, other.last, other.size = nil, nil, 0
+}
+
+// Splice moves every element of the other linked list into this list so that the first moved element ends up at the given index. The other list is left empty. The index must be between 0 and the size of the list, otherwise it will return a error.
+//
+// Parameters:
+//
+//	index: The targeted index of the first moved element.
+//	other: The linked list whose nodes are moved.
+//
+// Complexity:
+//
+//	Time - O(1) (Best Case)
+//	Time - O(n) (Worst Case)
+//	Space - O(1)
+//
+// Example:
+//
+//	myList := linkedlist.New[int]()
+//	myList.AddLast(1)
+//	myList.AddLast(4)
+//	other := linkedlist.New[int]()
+//	other.AddLast(2)
+//	other.AddLast(3)
+//	myList.Splice(1, &other) // myList: 1 -> 2 -> 3 -> 4, other: empty
+func (l *linkedList[T]) Splice(index int, other *linkedList[T]) error {
+	if index < 0 || index > l.size {
+		return errs.IndexOutOfRange(index, l.size)
+	}
+	if other == l || other.isEmpty() {
+		return nil
+	}
+
+	switch {
+	case index == l.size:
+		l.Concat(other)
+		return nil
+	case index == 0:
+		other.last.next = l.first
+		l.first = other.first
+	default:
+		previousNode := l.nodeAt(index - 1)
+		other.last.next = previousNode.next
+		previousNode.next = other.first
+	}
+	l.size += other.size
+	other.first, other.last, other.size = nil, nil, 0
+	return nil
+}
+
+// Split cuts the linked list at the given index. This list keeps the elements before the index and the elements from the index onwards are moved to the returned list. The index must be between 0 and the size of the list, otherwise it will return a error.
+//
+// Parameters:
+//
+//	index: The index of the first element of the returned list.
+//
+// Complexity:
+//
+//	Time - O(1) (Best Case)
+//	Time - O(n) (Worst Case)
+//	Space - O(1)
+//
+// Example:
+//
+//	myList := linkedlist.New[int]()
+//	myList.AddLast(1)
+//	myList.AddLast(2)
+//	myList.AddLast(3)
+//	tail, _ := myList.Split(1) // myList: 1, tail: 2 -> 3
+func (l *linkedList[T]) Split(index int) (linkedList[T], error) {
+	result := New[T]()
+	if index < 0 || index > l.size {
+		return result, errs.IndexOutOfRange(index, l.size)
+	}
+
+	switch {
+	case index == l.size:
+		return result, nil
+	case index == 0:
+		result.first, result.last, result.size = l.first, l.last, l.size
+		l.first, l.last, l.size = nil, nil, 0
+	default:
+		previousNode := l.nodeAt(index - 1)
+		result.first, result.last, result.size = previousNode.next, l.last, l.size-index
+		previousNode.next = nil
+		l.last = previousNode
+		l.size = index
+	}
+	return result, nil
+}
+
+// Rotate rotates the elements of the linked list to the right by k positions, the last k elements become the first ones. A negative k rotates to the left.
+//
+// Parameters:
+//
+//	k: The number of positions to rotate by.
+//
+// Complexity:
+//
+//	Time - O(n)
+//	Space - O(1)
+//
+// Example:
+//
+//	myList := linkedlist.New[int]()
+//	myList.AddLast(1)
+//	myList.AddLast(2)
+//	myList.AddLast(3)
+//	myList.Rotate(1) // myList: 3 -> 1 -> 2
+//	myList.Rotate(-1) // myList: 1 -> 2 -> 3
+func (l *linkedList[T]) Rotate(k int) {
+	if l.size < 2 {
+		return
+	}
+	k = ((k % l.size) + l.size) % l.size
+	if k == 0 {
+		return
+	}
+	newLast := l.nodeAt(l.size - k - 1)
+	l.last.next = l.first
+	l.first = newLast.next
+	l.last = newLast
+	newLast.next = nil
+}
+
+// Reverse reverses the order of the elements of the linked list in place by swapping the links of every node.
+//
+// Complexity:
+//
+//	Time - O(n)
+//	Space - O(1)
+func (l *doublyLinkedList[T]) Reverse() {
+	for current := l.first; current != nil; current = current.prev {
+		current.prev, current.next = current.next, current.prev
+	}
+	l.first, l.last = l.last, l.first
+}
+
+// Concat moves every element of the other linked list to the end of this list. The other list is left empty. Concatenating a list with itself does nothing.
+//
+// Complexity:
+//
+//	Time - O(1)
+//	Space - O(1)
+func (l *doublyLinkedList[T]) Concat(other *doublyLinkedList[T]) {
+	if other == l || other.isEmpty() {
+		return
+	}
+	if l.isEmpty() {
+		l.first = other.first
+	} else {
+		l.last.next = other.first
+		other.first.prev = l.last
+	}
+	l.last = other.last
+	l.size += other.size
+	other.first, other.last, other.size = nil, nil, 0
+}
+
+// Splice moves every element of the other linked list into this list so that the first moved element ends up at the given index. The other list is left empty. The index must be between 0 and the size of the list, otherwise it will return a error. The position is reached from whichever end of the list is closer, so at most half of the list is walked.
+//
+// Complexity:
+//
+//	Time - O(1) (Best Case)
+//	Time - O(n) (Worst Case)
+//	Space - O(1)
+func (l *doublyLinkedList[T]) Splice(index int, other *doublyLinkedList[T]) error {
+	if index < 0 || index > l.size {
+		return errs.IndexOutOfRange(index, l.size)
+	}
+	if other == l || other.isEmpty() {
+		return nil
+	}
+	if index == l.size {
+		l.Concat(other)
+		return nil
+	}
+
+	nextNode := l.nodeAt(index)
+	if nextNode.prev == nil {
+		l.first = other.first
+	} else {
+		nextNode.prev.next = other.first
+		other.first.prev = nextNode.prev
+	}
+	other.last.next = nextNode
+	nextNode.prev = other.last
+	l.size += other.size
+	other.first, other.last, other.size = nil, nil, 0
+	return nil
+}
+
+// Split cuts the linked list at the given index. This list keeps the elements before the index and the elements from the index onwards are moved to the returned list. The index must be between 0 and the size of the list, otherwise it will return a error. The cut is reached from whichever end of the list is closer, so at most half of the list is walked.
+//
+// Complexity:
+//
+//	Time - O(1) (Best Case)
+//	Time - O(n) (Worst Case)
+//	Space - O(1)
+func (l *doublyLinkedList[T]) Split(index int) (doublyLinkedList[T], error) {
+	result := NewDoubly[T]()
+	if index < 0 || index > l.size {
+		return result, errs.IndexOutOfRange(index, l.size)
+	}
+	if index == l.size {
+		return result, nil
+	}
+
+	firstNode := l.nodeAt(index)
+	result.first, result.last, result.size = firstNode, l.last, l.size-index
+	l.last = firstNode.prev
+	if l.last == nil {
+		l.first = nil
+	} else {
+		l.last.next = nil
+	}
+	firstNode.prev = nil
+	l.size = index
+	return result, nil
+}
+
+// Rotate rotates the elements of the linked list to the right by k positions, the last k elements become the first ones. A negative k rotates to the left. The new first element is reached from whichever end of the list is closer, so at most half of the list is walked.
+//
+// Complexity:
+//
+//	Time - O(n)
+//	Space - O(1)
+func (l *doublyLinkedList[T]) Rotate(k int) {
+	if l.size < 2 {
+		return
+	}
+	k = ((k % l.size) + l.size) % l.size
+	if k == 0 {
+		return
+	}
+	newFirst := l.nodeAt(l.size - k)
+	l.last.next = l.first
+	l.first.prev = l.last
+	l.first = newFirst
+	l.last = newFirst.prev
+	l.last.next = nil
+	newFirst.prev = nil
+}
@@ -0,0 +1,222 @@
+package linkedlist
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func singlyOf(items ...int) *linkedList[int] {
+	list := Of(items...)
+	return &list
+}
+
+func doublyOf(items ...int) *doublyLinkedList[int] {
+	list := DoublyOf(items...)
+	return &list
+}
+
+// assertList checks the elements, the size and the first/last pointers of a list, and the backward links of a doubly list.
+func assertList(t *testing.T, expected []int, list LinkedList[int]) {
+	t.Helper()
+	assert.Equal(t, expected, list.ToSlice())
+	assert.Equal(t, len(expected), list.Size())
+	first, firstErr := list.First()
+	last, lastErr := list.Last()
+	if len(expected) == 0 {
+		assert.NotNil(t, firstErr)
+		assert.NotNil(t, lastErr)
+	} else {
+		assert.Equal(t, expected[0], first)
+		assert.Equal(t, expected[len(expected)-1], last)
+	}
+	if doubly, ok := list.(*doublyLinkedList[int]); ok {
+		backward := []int{}
+		for _, item := range doubly.Backward() {
+			backward = append(backward, item)
+		}
+		reversed := slices.Clone(expected)
+		slices.Reverse(reversed)
+		assert.Equal(t, reversed, backward)
+	}
+}
+
+func TestReverse(t *testing.T) {
+	tests := []struct {
+		name     string
+		items    []int
+		expected []int
+	}{
+		{"empty", []int{}, []int{}},
+		{"single", []int{1}, []int{1}},
+		{"two", []int{1, 2}, []int{2, 1}},
+		{"many", []int{1, 2, 3, 4, 5}, []int{5, 4, 3, 2, 1}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			singly := singlyOf(test.items...)
+			singly.Reverse()
+			assertList(t, test.expected, singly)
+
+			doubly := doublyOf(test.items...)
+			doubly.Reverse()
+			assertList(t, test.expected, doubly)
+		})
+	}
+}
+
+func TestConcat(t *testing.T) {
+	tests := []struct {
+		name     string
+		first    []int
+		second   []int
+		expected []int
+	}{
+		{"both empty", []int{}, []int{}, []int{}},
+		{"empty first", []int{}, []int{1, 2}, []int{1, 2}},
+		{"empty second", []int{1, 2}, []int{}, []int{1, 2}},
+		{"both filled", []int{1, 2}, []int{3, 4, 5}, []int{1, 2, 3, 4, 5}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			singly, other := singlyOf(test.first...), singlyOf(test.second...)
+			singly.Concat(other)
+			assertList(t, test.expected, singly)
+			assertList(t, []int{}, other)
+			singly.AddLast(6)
+			assertList(t, append(slices.Clone(test.expected), 6), singly)
+
+			doubly, otherDoubly := doublyOf(test.first...), doublyOf(test.second...)
+			doubly.Concat(otherDoubly)
+			assertList(t, test.expected, doubly)
+			assertList(t, []int{}, otherDoubly)
+			doubly.AddLast(6)
+			assertList(t, append(slices.Clone(test.expected), 6), doubly)
+		})
+	}
+
+	t.Run("itself", func(t *testing.T) {
+		singly := singlyOf(1, 2)
+		singly.Concat(singly)
+		assertList(t, []int{1, 2}, singly)
+
+		doubly := doublyOf(1, 2)
+		doubly.Concat(doubly)
+		assertList(t, []int{1, 2}, doubly)
+	})
+}
+
+func TestSplice(t *testing.T) {
+	tests := []struct {
+		name     string
+		items    []int
+		other    []int
+		index    int
+		expected []int
+		err      bool
+	}{
+		{"into empty", []int{}, []int{1, 2}, 0, []int{1, 2}, false},
+		{"front", []int{3, 4}, []int{1, 2}, 0, []int{1, 2, 3, 4}, false},
+		{"middle", []int{1, 4}, []int{2, 3}, 1, []int{1, 2, 3, 4}, false},
+		{"end", []int{1, 2}, []int{3, 4}, 2, []int{1, 2, 3, 4}, false},
+		{"empty other", []int{1, 2}, []int{}, 1, []int{1, 2}, false},
+		{"negative index", []int{1, 2}, []int{3}, -1, []int{1, 2}, true},
+		{"index out of bound", []int{1, 2}, []int{3}, 3, []int{1, 2}, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			otherRemaining := []int{}
+			if test.err {
+				otherRemaining = test.other
+			}
+
+			singly, other := singlyOf(test.items...), singlyOf(test.other...)
+			err := singly.Splice(test.index, other)
+			assert.Equal(t, test.err, err != nil)
+			assertList(t, test.expected, singly)
+			assertList(t, otherRemaining, other)
+
+			doubly, otherDoubly := doublyOf(test.items...), doublyOf(test.other...)
+			err = doubly.Splice(test.index, otherDoubly)
+			assert.Equal(t, test.err, err != nil)
+			assertList(t, test.expected, doubly)
+			assertList(t, otherRemaining, otherDoubly)
+		})
+	}
+}
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		name  string
+		items []int
+		index int
+		head  []int
+		tail  []int
+		err   bool
+	}{
+		{"empty", []int{}, 0, []int{}, []int{}, false},
+		{"at start", []int{1, 2, 3}, 0, []int{}, []int{1, 2, 3}, false},
+		{"middle", []int{1, 2, 3, 4}, 2, []int{1, 2}, []int{3, 4}, false},
+		{"before last", []int{1, 2, 3}, 2, []int{1, 2}, []int{3}, false},
+		{"at end", []int{1, 2, 3}, 3, []int{1, 2, 3}, []int{}, false},
+		{"negative index", []int{1, 2, 3}, -1, []int{1, 2, 3}, []int{}, true},
+		{"index out of bound", []int{1, 2, 3}, 4, []int{1, 2, 3}, []int{}, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			singly := singlyOf(test.items...)
+			tail, err := singly.Split(test.index)
+			assert.Equal(t, test.err, err != nil)
+			assertList(t, test.head, singly)
+			assertList(t, test.tail, &tail)
+
+			doubly := doublyOf(test.items...)
+			doublyTail, err := doubly.Split(test.index)
+			assert.Equal(t, test.err, err != nil)
+			assertList(t, test.head, doubly)
+			assertList(t, test.tail, &doublyTail)
+		})
+	}
+}
+
+func TestRotate(t *testing.T) {
+	tests := []struct {
+		name     string
+		items    []int
+		k        int
+		expected []int
+	}{
+		{"empty", []int{}, 3, []int{}},
+		{"single", []int{1}, 3, []int{1}},
+		{"zero", []int{1, 2, 3}, 0, []int{1, 2, 3}},
+		{"right", []int{1, 2, 3, 4}, 1, []int{4, 1, 2, 3}},
+		{"right by size", []int{1, 2, 3, 4}, 4, []int{1, 2, 3, 4}},
+		{"right beyond size", []int{1, 2, 3, 4}, 6, []int{3, 4, 1, 2}},
+		{"left", []int{1, 2, 3, 4}, -1, []int{2, 3, 4, 1}},
+		{"left beyond size", []int{1, 2, 3, 4}, -7, []int{4, 1, 2, 3}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			singly := singlyOf(test.items...)
+			singly.Rotate(test.k)
+			assertList(t, test.expected, singly)
+
+			doubly := doublyOf(test.items...)
+			doubly.Rotate(test.k)
+			assertList(t, test.expected, doubly)
+		})
+	}
+}
+
+func TestStructuralErrors(t *testing.T) {
+	singly := singlyOf(1, 2)
+	_, err := singly.Split(3)
+	assert.ErrorIs(t, err, ErrIndexOutOfRange)
+	assert.ErrorIs(t, singly.Splice(-1, singlyOf(3)), ErrIndexOutOfRange)
+
+	doubly := doublyOf(1, 2)
+	_, err = doubly.Split(-1)
+	assert.ErrorIs(t, err, ErrIndexOutOfRange)
+	assert.ErrorIs(t, doubly.Splice(3, doublyOf(3)), ErrIndexOutOfRange)
+}
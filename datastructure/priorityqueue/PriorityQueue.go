@@ -0,0 +1,256 @@
+package priorityqueue
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+
+	"github.com/OmarFaruk-0x01/go_algorithms/datastructure/errs"
+)
+
+var (
+	// ErrEmpty is returned when an item is read or removed from an empty priority queue.
+	ErrEmpty = errs.ErrEmpty
+	// ErrFull is returned when an item is added to a priority queue that reached its max.
+	ErrFull = errs.ErrFull
+	// ErrInvalidHandle is returned when a handle was already removed from the queue or belongs to another queue.
+	ErrInvalidHandle = errors.New("handle is not in the priority queue")
+)
+
+// Handle points to an item inside a priority queue, so the item can later be updated or removed without searching for it.
+//
+// Fields:
+//
+//	value: The item.
+//	index: The position of the handle inside the heap, -1 once it left the queue.
+//	owner: The queue the handle belongs to.
+type Handle[T any] struct {
+	value T
+	index int
+	owner *priorityQueue[T]
+}
+
+// Value returns the item the handle points to.
+func (h *Handle[T]) Value() T {
+	return h.value
+}
+
+// priorityQueue is a binary heap ordered by a comparison function. The item the comparison reports as the smallest is always at the front, so passing a reversed comparison turns it into a max queue.
+//
+// Fields:
+//
+//	heap: The handles laid out as an implicit binary tree, the children of i are 2i+1 and 2i+2.
+//	compare: Returns a negative number when a must leave the queue before b.
+//	max: The maximum number of items the queue accepts.
+type priorityQueue[T any] struct {
+	heap    []*Handle[T]
+	compare func(a, b T) int
+	max     int
+	_nil    T
+}
+
+// New creates a priority queue of up to maxItem items where the item compare reports as the smallest is dequeued first.
+//
+// Example:
+//
+//	byDeadline := priorityqueue.New(10, func(a, b Job) int { return a.deadline.Compare(b.deadline) })
+//	byDeadline.Enqueue(job)
+func New[T any](maxItem int, compare func(a, b T) int) *priorityQueue[T] {
+	return &priorityQueue[T]{heap: []*Handle[T]{}, compare: compare, max: maxItem}
+}
+
+// NewMin creates a priority queue that dequeues the smallest item first.
+func NewMin[T cmp.Ordered](maxItem int) *priorityQueue[T] {
+	return New(maxItem, cmp.Compare[T])
+}
+
+// NewMax creates a priority queue that dequeues the biggest item first.
+func NewMax[T cmp.Ordered](maxItem int) *priorityQueue[T] {
+	return New(maxItem, func(a, b T) int { return cmp.Compare(b, a) })
+}
+
+// Push adds an item and returns its handle, which can be passed to UpdatePriority and Remove later.
+//
+// Complexity:
+//
+//	Time - O(log n)
+func (q *priorityQueue[T]) Push(item T) (*Handle[T], error) {
+	if q.IsFull() {
+		return nil, ErrFull
+	}
+	handle := &Handle[T]{value: item, index: len(q.heap), owner: q}
+	q.heap = append(q.heap, handle)
+	q.up(handle.index)
+	return handle, nil
+}
+
+// Enqueue adds an item.
+//
+// Complexity:
+//
+//	Time - O(log n)
+func (q *priorityQueue[T]) Enqueue(item T) error {
+	_, err := q.Push(item)
+	return err
+}
+
+// Dequeue removes and returns the front item.
+//
+// Complexity:
+//
+//	Time - O(log n)
+func (q *priorityQueue[T]) Dequeue() (T, error) {
+	if q.IsEmpty() {
+		return q._nil, ErrEmpty
+	}
+	return q.removeAt(0).value, nil
+}
+
+// Peek returns the front item without removing it.
+//
+// Complexity:
+//
+//	Time - O(1)
+func (q *priorityQueue[T]) Peek() (T, error) {
+	if q.IsEmpty() {
+		return q._nil, ErrEmpty
+	}
+	return q.heap[0].value, nil
+}
+
+// UpdatePriority replaces the item behind the handle and moves it to its new place in the queue.
+//
+// Complexity:
+//
+//	Time - O(log n)
+func (q *priorityQueue[T]) UpdatePriority(handle *Handle[T], item T) error {
+	if !q.owns(handle) {
+		return ErrInvalidHandle
+	}
+	handle.value = item
+	if !q.up(handle.index) {
+		q.down(handle.index)
+	}
+	return nil
+}
+
+// Remove takes the item behind the handle out of the queue. The handle can not be used afterwards.
+//
+// Complexity:
+//
+//	Time - O(log n)
+func (q *priorityQueue[T]) Remove(handle *Handle[T]) error {
+	if !q.owns(handle) {
+		return ErrInvalidHandle
+	}
+	q.removeAt(handle.index)
+	return nil
+}
+
+func (q *priorityQueue[T]) Size() int {
+	return len(q.heap)
+}
+
+func (q *priorityQueue[T]) IsEmpty() bool {
+	return len(q.heap) == 0
+}
+
+func (q *priorityQueue[T]) IsFull() bool {
+	return len(q.heap) >= q.max
+}
+
+// SetMax changes the maximum number of items. Items already in the queue are kept when it shrinks.
+func (q *priorityQueue[T]) SetMax(max int) {
+	q.max = max
+}
+
+// All returns an iterator over the items in the order Dequeue would return them. Items the comparison reports as equal may come in any order.
+//
+// Complexity:
+//
+//	Time - O(n log n)
+//	Space - O(n)
+func (q *priorityQueue[T]) All() iter.Seq2[int, T] {
+	return slices.All(q.sorted())
+}
+
+// Values returns an iterator over the items in the order Dequeue would return them.
+//
+// Complexity:
+//
+//	Time - O(n log n)
+//	Space - O(n)
+func (q *priorityQueue[T]) Values() iter.Seq[T] {
+	return slices.Values(q.sorted())
+}
+
+func (q *priorityQueue[T]) String() string {
+	return fmt.Sprintf("%v", q.sorted())
+}
+
+// sorted copies the items in the order Dequeue would return them.
+func (q *priorityQueue[T]) sorted() []T {
+	items := make([]T, 0, len(q.heap))
+	for _, handle := range q.heap {
+		items = append(items, handle.value)
+	}
+	slices.SortFunc(items, q.compare)
+	return items
+}
+
+func (q *priorityQueue[T]) owns(handle *Handle[T]) bool {
+	return handle != nil && handle.owner == q && handle.index >= 0
+}
+
+// removeAt takes the handle at the given heap index out by swapping it with the last one and restoring the heap around the moved handle.
+func (q *priorityQueue[T]) removeAt(index int) *Handle[T] {
+	last := len(q.heap) - 1
+	q.swap(index, last)
+	handle := q.heap[last]
+	q.heap[last] = nil
+	q.heap = q.heap[:last]
+	if index < last && !q.up(index) {
+		q.down(index)
+	}
+	handle.index = -1
+	return handle
+}
+
+// up moves the handle at the given index towards the root while it is smaller than its parent. It reports whether the handle moved.
+func (q *priorityQueue[T]) up(index int) bool {
+	start := index
+	for index > 0 {
+		parent := (index - 1) / 2
+		if q.compare(q.heap[index].value, q.heap[parent].value) >= 0 {
+			break
+		}
+		q.swap(index, parent)
+		index = parent
+	}
+	return index != start
+}
+
+// down moves the handle at the given index towards the leaves while one of its children is smaller.
+func (q *priorityQueue[T]) down(index int) {
+	for {
+		smallest := index
+		for _, child := range []int{2*index + 1, 2*index + 2} {
+			if child < len(q.heap) && q.compare(q.heap[child].value, q.heap[smallest].value) < 0 {
+				smallest = child
+			}
+		}
+		if smallest == index {
+			return
+		}
+		q.swap(index, smallest)
+		index = smallest
+	}
+}
+
+func (q *priorityQueue[T]) swap(i int, j int) {
+	q.heap[i], q.heap[j] = q.heap[j], q.heap[i]
+	q.heap[i].index = i
+	q.heap[j].index = j
+}
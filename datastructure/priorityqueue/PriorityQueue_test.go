@@ -0,0 +1,181 @@
+package priorityqueue
+
+import (
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/OmarFaruk-0x01/go_algorithms/datastructure/queue"
+	"github.com/stretchr/testify/assert"
+)
+
+var _ queue.Queue[int] = (*priorityQueue[int])(nil)
+
+type job struct {
+	name     string
+	deadline time.Time
+}
+
+func byDeadline(a, b job) int {
+	return a.deadline.Compare(b.deadline)
+}
+
+func TestEnqueueDequeueMin(t *testing.T) {
+	q := NewMin[int](10)
+	_, err := q.Dequeue()
+	assert.ErrorIs(t, err, ErrEmpty)
+	_, err = q.Peek()
+	assert.ErrorIs(t, err, ErrEmpty)
+
+	for _, item := range []int{5, 3, 8, 1, 9, 2} {
+		assert.Nil(t, q.Enqueue(item))
+	}
+	assert.Equal(t, 6, q.Size())
+	front, err := q.Peek()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, front)
+
+	var got []int
+	for !q.IsEmpty() {
+		item, err := q.Dequeue()
+		assert.Nil(t, err)
+		got = append(got, item)
+	}
+	assert.Equal(t, []int{1, 2, 3, 5, 8, 9}, got)
+}
+
+func TestEnqueueDequeueMax(t *testing.T) {
+	q := NewMax[string](10)
+	for _, item := range []string{"b", "d", "a", "c"} {
+		q.Enqueue(item)
+	}
+	assert.Equal(t, []string{"d", "c", "b", "a"}, slices.Collect(q.Values()))
+	item, _ := q.Dequeue()
+	assert.Equal(t, "d", item)
+}
+
+func TestRandomOrder(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	q := NewMin[int](1000)
+	items := make([]int, 1000)
+	for i := range items {
+		items[i] = r.Intn(100)
+		q.Enqueue(items[i])
+	}
+	slices.Sort(items)
+	for _, want := range items {
+		item, _ := q.Dequeue()
+		assert.Equal(t, want, item)
+	}
+}
+
+func TestMaxItem(t *testing.T) {
+	q := NewMin[int](2)
+	assert.Nil(t, q.Enqueue(2))
+	_, err := q.Push(1)
+	assert.Nil(t, err)
+	assert.True(t, q.IsFull())
+	handle, err := q.Push(3)
+	assert.ErrorIs(t, err, ErrFull)
+	assert.Nil(t, handle)
+
+	q.SetMax(1)
+	assert.True(t, q.IsFull())
+	assert.Equal(t, 2, q.Size())
+	q.SetMax(3)
+	assert.False(t, q.IsFull())
+	assert.Nil(t, q.Enqueue(3))
+}
+
+func TestUpdatePriority(t *testing.T) {
+	now := time.Now()
+	q := New(10, byDeadline)
+	report, _ := q.Push(job{"report", now.Add(3 * time.Hour)})
+	backup, _ := q.Push(job{"backup", now.Add(time.Hour)})
+	q.Push(job{"deploy", now.Add(2 * time.Hour)})
+
+	front, _ := q.Peek()
+	assert.Equal(t, "backup", front.name)
+
+	assert.Nil(t, q.UpdatePriority(report, job{"report", now}))
+	assert.Equal(t, "report", report.Value().name)
+	front, _ = q.Peek()
+	assert.Equal(t, "report", front.name)
+
+	assert.Nil(t, q.UpdatePriority(backup, job{"backup", now.Add(4 * time.Hour)}))
+	var names []string
+	for item := range q.Values() {
+		names = append(names, item.name)
+	}
+	assert.Equal(t, []string{"report", "deploy", "backup"}, names)
+}
+
+func TestRemove(t *testing.T) {
+	q := NewMin[int](10)
+	handles := map[int]*Handle[int]{}
+	for _, item := range []int{4, 7, 1, 9, 3, 6} {
+		handles[item], _ = q.Push(item)
+	}
+
+	assert.Nil(t, q.Remove(handles[7]))
+	assert.Nil(t, q.Remove(handles[1]))
+	assert.Equal(t, 4, q.Size())
+	assert.Equal(t, []int{3, 4, 6, 9}, slices.Collect(q.Values()))
+
+	assert.ErrorIs(t, q.Remove(handles[7]), ErrInvalidHandle)
+	assert.ErrorIs(t, q.UpdatePriority(handles[1], 0), ErrInvalidHandle)
+	assert.ErrorIs(t, q.Remove(nil), ErrInvalidHandle)
+
+	other := NewMin[int](10)
+	foreign, _ := other.Push(5)
+	assert.ErrorIs(t, q.Remove(foreign), ErrInvalidHandle)
+
+	front, _ := q.Dequeue()
+	assert.Equal(t, 3, front)
+	assert.ErrorIs(t, q.Remove(handles[3]), ErrInvalidHandle)
+}
+
+func TestRandomUpdatesAndRemoves(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	q := NewMin[int](200)
+	var handles []*Handle[int]
+	for i := 0; i < 200; i++ {
+		handle, _ := q.Push(r.Intn(1000))
+		handles = append(handles, handle)
+	}
+	for i, handle := range handles {
+		if i%3 == 0 {
+			q.Remove(handle)
+		} else {
+			q.UpdatePriority(handle, r.Intn(1000))
+		}
+	}
+
+	var want []int
+	for i, handle := range handles {
+		if i%3 != 0 {
+			want = append(want, handle.Value())
+		}
+	}
+	slices.Sort(want)
+	var got []int
+	for !q.IsEmpty() {
+		item, _ := q.Dequeue()
+		got = append(got, item)
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestAllAndString(t *testing.T) {
+	q := New(10, strings.Compare)
+	for _, item := range []string{"c", "a", "b"} {
+		q.Enqueue(item)
+	}
+	for index, item := range q.All() {
+		assert.Equal(t, string(rune('a'+index)), item)
+	}
+	assert.Equal(t, "[a b c]", q.String())
+	assert.Equal(t, 3, q.Size())
+}
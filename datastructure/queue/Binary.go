@@ -0,0 +1,69 @@
+package queue
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/OmarFaruk-0x01/go_algorithms/datastructure/internal/gobstream"
+	"github.com/OmarFaruk-0x01/go_algorithms/datastructure/stack"
+)
+
+// Encode writes the queue to w in a compact binary form: a gob header with the size and the max followed by the items one by one from the front to the back. Gob can not encode nil pointers, so a queue holding one fails to encode.
+func (s queue[T]) Encode(w io.Writer) error {
+	return gobstream.Encode(w, gobstream.Header{Size: s.size, Max: s.max}, s.Values())
+}
+
+// Decode replaces the max and the items of the queue with the ones Encode wrote to r. The queue is only changed if the whole queue could be read. Unless r implements io.ByteReader, Decode may read past the end of the encoded queue.
+func (s *queue[T]) Decode(r io.Reader) error {
+	items := []T{}
+	header, err := gobstream.Decode(r, func(item T) { items = append(items, item) })
+	if err != nil {
+		return err
+	}
+	*s = queue[T]{queue: items, size: len(items), max: header.Max}
+	return nil
+}
+
+// MarshalBinary encodes the queue in the form Encode writes. It also makes the queue usable with encoding/gob.
+func (s queue[T]) MarshalBinary() ([]byte, error) {
+	var buffer bytes.Buffer
+	if err := s.Encode(&buffer); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// UnmarshalBinary replaces the max and the items of the queue with the ones MarshalBinary encoded.
+func (s *queue[T]) UnmarshalBinary(data []byte) error {
+	return s.Decode(bytes.NewReader(data))
+}
+
+// Encode writes the queue to w in the same form as the slice queue, so either queue can decode what the other one encoded.
+func (q stack_queue[T]) Encode(w io.Writer) error {
+	return gobstream.Encode(w, gobstream.Header{Size: q.Size(), Max: q.max}, q.Values())
+}
+
+// Decode replaces the max and the items of the queue with the ones Encode wrote to r. The items are pushed on the first stack as they arrive, so the queue is ready to use once they are read.
+func (q *stack_queue[T]) Decode(r io.Reader) error {
+	back := stack.New[T]()
+	header, err := gobstream.Decode(r, back.Push)
+	if err != nil {
+		return err
+	}
+	*q = stack_queue[T]{stack_1: back, stack_2: stack.New[T](), max: header.Max}
+	return nil
+}
+
+// MarshalBinary encodes the queue in the form Encode writes. It also makes the queue usable with encoding/gob.
+func (q stack_queue[T]) MarshalBinary() ([]byte, error) {
+	var buffer bytes.Buffer
+	if err := q.Encode(&buffer); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// UnmarshalBinary replaces the max and the items of the queue with the ones MarshalBinary encoded.
+func (q *stack_queue[T]) UnmarshalBinary(data []byte) error {
+	return q.Decode(bytes.NewReader(data))
+}
@@ -0,0 +1,89 @@
+package queue
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"io"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	_ encoding.BinaryMarshaler   = queue[int]{}
+	_ encoding.BinaryUnmarshaler = (*queue[int])(nil)
+	_ encoding.BinaryMarshaler   = stack_queue[int]{}
+	_ encoding.BinaryUnmarshaler = (*stack_queue[int])(nil)
+)
+
+func TestEncodeDecode(t *testing.T) {
+	original, _ := Of(4, member{"Omar Faruk", 20}, member{"Tanvir Raj", 25}, member{"Sadik", 30})
+	original.Dequeue()
+	var buffer bytes.Buffer
+	assert.Nil(t, original.Encode(&buffer))
+
+	decoded := NewSliceQueue[member](0)
+	assert.Nil(t, decoded.Decode(&buffer))
+	assert.True(t, Equal[member](&decoded, &original))
+	assert.Nil(t, decoded.Enqueue(member{"d", 4}))
+	assert.Nil(t, decoded.Enqueue(member{"e", 5}))
+	assert.True(t, decoded.IsFull())
+
+	// The slice queue and the stack queue share the form.
+	buffer.Reset()
+	assert.Nil(t, original.Encode(&buffer))
+	sq := NewStackQueue[member](0)
+	assert.Nil(t, sq.Decode(&buffer))
+	assert.True(t, Equal[member](sq, &original))
+	front, _ := sq.Dequeue()
+	assert.Equal(t, member{"Tanvir Raj", 25}, front)
+	assert.Nil(t, sq.Enqueue(member{"d", 4}))
+	assert.Nil(t, sq.Enqueue(member{"e", 5}))
+	assert.Nil(t, sq.Enqueue(member{"f", 6}))
+	assert.ErrorIs(t, sq.Enqueue(member{"g", 7}), ErrFull)
+
+	buffer.Reset()
+	assert.Nil(t, sq.Encode(&buffer))
+	assert.Nil(t, decoded.Decode(&buffer))
+	assert.Equal(t, []member{{"Sadik", 30}, {"d", 4}, {"e", 5}, {"f", 6}}, slices.Collect(decoded.Values()))
+}
+
+func TestDecodeKeepsQueueOnError(t *testing.T) {
+	q, _ := Of(3, 1, 2, 3)
+	data, err := q.MarshalBinary()
+	assert.Nil(t, err)
+
+	kept, _ := Of(1, 7)
+	assert.ErrorIs(t, kept.UnmarshalBinary(data[:len(data)-2]), io.ErrUnexpectedEOF)
+	assert.Equal(t, []int{7}, slices.Collect(kept.Values()))
+	assert.True(t, kept.IsFull())
+
+	sq := NewStackQueue[int](1)
+	sq.Enqueue(7)
+	assert.NotNil(t, sq.UnmarshalBinary(data[:len(data)-2]))
+	assert.Equal(t, []int{7}, slices.Collect(sq.Values()))
+	assert.Nil(t, sq.UnmarshalBinary(data))
+	assert.True(t, sq.IsFull())
+}
+
+func TestGob(t *testing.T) {
+	type jobs struct {
+		Pending queue[member]
+		Retry   *stack_queue[member]
+	}
+	pending, _ := Of(4, member{"a", 1}, member{"b", 2})
+	retry := NewStackQueue[member](2)
+	retry.Enqueue(member{"c", 3})
+	original := jobs{Pending: pending, Retry: retry}
+
+	var buffer bytes.Buffer
+	assert.Nil(t, gob.NewEncoder(&buffer).Encode(original))
+	var decoded jobs
+	assert.Nil(t, gob.NewDecoder(&buffer).Decode(&decoded))
+	assert.True(t, Equal[member](&decoded.Pending, &original.Pending))
+	assert.True(t, Equal[member](decoded.Retry, original.Retry))
+	assert.Nil(t, decoded.Retry.Enqueue(member{"d", 4}))
+	assert.True(t, decoded.Retry.IsFull())
+}
@@ -0,0 +1,186 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"slices"
+	"sync"
+
+	"github.com/OmarFaruk-0x01/go_algorithms/datastructure/errs"
+)
+
+// ErrClosed is returned when an item is put into a closed blocking queue, or taken from a closed blocking queue that has been drained.
+var ErrClosed = errs.ErrClosed
+
+// blockingQueue wraps a bounded Queue so producers wait while it is full and consumers wait while it is empty, instead of getting ErrFull and ErrEmpty back and retrying in a loop.
+//
+// Fields:
+//
+//	mu: The mutex guarding the wrapped queue and the closed flag.
+//	notEmpty: Signalled whenever an item is added, consumers wait on it.
+//	notFull: Signalled whenever an item is removed or the max grows, producers wait on it.
+//	queue: The wrapped queue, it must not be used directly once wrapped.
+//	closed: Whether Close has been called.
+type blockingQueue[T any] struct {
+	mu       sync.Mutex
+	notEmpty *sync.Cond
+	notFull  *sync.Cond
+	queue    Queue[T]
+	closed   bool
+}
+
+// NewBlockingQueue wraps the given queue so Put and Take can wait for room or for items.
+func NewBlockingQueue[T any](q Queue[T]) *blockingQueue[T] {
+	b := &blockingQueue[T]{queue: q}
+	b.notEmpty = sync.NewCond(&b.mu)
+	b.notFull = sync.NewCond(&b.mu)
+	return b
+}
+
+// Put adds an item to the back of the queue, waiting while the queue is full. It returns the error of the context if the context is done before there is room, or ErrClosed if the queue is closed.
+func (b *blockingQueue[T]) Put(ctx context.Context, item T) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for !b.closed && b.queue.IsFull() {
+		if err := b.wait(ctx, b.notFull); err != nil {
+			return err
+		}
+	}
+	if b.closed {
+		return ErrClosed
+	}
+	if err := b.queue.Enqueue(item); err != nil {
+		return err
+	}
+	b.notEmpty.Signal()
+	return nil
+}
+
+// Take removes the front item of the queue, waiting while the queue is empty. It returns the error of the context if the context is done before an item arrives. Once the queue is closed the remaining items are still handed out and ErrClosed is returned after the last one.
+func (b *blockingQueue[T]) Take(ctx context.Context) (T, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for !b.closed && b.queue.IsEmpty() {
+		if err := b.wait(ctx, b.notEmpty); err != nil {
+			var _nil T
+			return _nil, err
+		}
+	}
+	if b.queue.IsEmpty() {
+		var _nil T
+		return _nil, ErrClosed
+	}
+	item, err := b.queue.Dequeue()
+	if err == nil {
+		b.notFull.Signal()
+	}
+	return item, err
+}
+
+// Close stops the queue from accepting items and wakes every waiting producer and consumer. Waiting producers get ErrClosed, consumers keep taking the remaining items.
+func (b *blockingQueue[T]) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closed = true
+	b.notEmpty.Broadcast()
+	b.notFull.Broadcast()
+}
+
+// Enqueue adds an item without waiting. It returns ErrFull when the queue is full and ErrClosed when the queue is closed.
+func (b *blockingQueue[T]) Enqueue(item T) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return ErrClosed
+	}
+	if err := b.queue.Enqueue(item); err != nil {
+		return err
+	}
+	b.notEmpty.Signal()
+	return nil
+}
+
+// Dequeue removes the front item without waiting. It returns ErrEmpty when the queue is empty.
+func (b *blockingQueue[T]) Dequeue() (T, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	item, err := b.queue.Dequeue()
+	if err == nil {
+		b.notFull.Signal()
+	}
+	return item, err
+}
+
+func (b *blockingQueue[T]) Peek() (T, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.queue.Peek()
+}
+
+func (b *blockingQueue[T]) Size() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.queue.Size()
+}
+
+func (b *blockingQueue[T]) IsEmpty() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.queue.IsEmpty()
+}
+
+func (b *blockingQueue[T]) IsFull() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.queue.IsFull()
+}
+
+// SetMax changes the max of the wrapped queue and wakes the waiting producers in case there is room now.
+func (b *blockingQueue[T]) SetMax(max int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.queue.SetMax(max)
+	b.notFull.Broadcast()
+}
+
+// All returns an iterator over a snapshot of the items of the queue from the front to the back.
+func (b *blockingQueue[T]) All() iter.Seq2[int, T] {
+	return slices.All(b.snapshot())
+}
+
+// Values returns an iterator over a snapshot of the items of the queue from the front to the back.
+func (b *blockingQueue[T]) Values() iter.Seq[T] {
+	return slices.Values(b.snapshot())
+}
+
+func (b *blockingQueue[T]) String() string {
+	return fmt.Sprintf("%v", b.snapshot())
+}
+
+// wait blocks on the condition until it is signalled or the context is done. The mutex must be held, it is released while waiting.
+func (b *blockingQueue[T]) wait(ctx context.Context, cond *sync.Cond) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	stop := context.AfterFunc(ctx, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		cond.Broadcast()
+	})
+	defer stop()
+	cond.Wait()
+	if err := ctx.Err(); err != nil {
+		// The wake up may have been a signal meant for this waiter, pass it on so it is not lost.
+		cond.Signal()
+		return err
+	}
+	return nil
+}
+
+// snapshot copies the items of the wrapped queue from the front to the back.
+func (b *blockingQueue[T]) snapshot() []T {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return slices.Collect(b.queue.Values())
+}
@@ -0,0 +1,154 @@
+package queue
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockingPutTake(t *testing.T) {
+	b := NewBlockingQueue[int](NewRingQueue[int](2))
+	ctx := context.Background()
+
+	taken := make(chan int, 10)
+	go func() {
+		for i := 0; i < 10; i++ {
+			item, err := b.Take(ctx)
+			assert.Nil(t, err)
+			taken <- item
+		}
+		close(taken)
+	}()
+	for i := 0; i < 10; i++ {
+		assert.Nil(t, b.Put(ctx, i))
+	}
+
+	expected := 0
+	for item := range taken {
+		assert.Equal(t, expected, item)
+		expected++
+	}
+	assert.Equal(t, 10, expected)
+	assert.True(t, b.IsEmpty())
+}
+
+func TestBlockingPutWaitsForRoom(t *testing.T) {
+	b := NewBlockingQueue[int](NewRingQueue[int](1))
+	ctx := context.Background()
+	assert.Nil(t, b.Put(ctx, 1))
+
+	done := make(chan error)
+	go func() {
+		done <- b.Put(ctx, 2)
+	}()
+	select {
+	case <-done:
+		t.Fatal("Put returned while the queue was full")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	item, err := b.Take(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, item)
+	assert.Nil(t, <-done)
+	front, _ := b.Peek()
+	assert.Equal(t, 2, front)
+}
+
+func TestBlockingDeadline(t *testing.T) {
+	b := NewBlockingQueue[int](NewRingQueue[int](1))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err := b.Take(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	assert.Nil(t, b.Put(context.Background(), 1))
+	ctx, cancel = context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+	assert.ErrorIs(t, b.Put(ctx, 2), context.Canceled)
+	assert.ErrorIs(t, b.Put(ctx, 2), context.Canceled)
+	assert.Equal(t, 1, b.Size())
+}
+
+func TestBlockingClose(t *testing.T) {
+	b := NewBlockingQueue[int](NewRingQueue[int](3))
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 4)
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := b.Take(ctx)
+			errs <- err
+		}()
+	}
+	time.Sleep(10 * time.Millisecond)
+	assert.Nil(t, b.Enqueue(1))
+	b.Close()
+	wg.Wait()
+	close(errs)
+
+	closed := 0
+	for err := range errs {
+		if err != nil {
+			assert.ErrorIs(t, err, ErrClosed)
+			closed++
+		}
+	}
+	assert.Equal(t, 3, closed)
+	assert.ErrorIs(t, b.Put(ctx, 2), ErrClosed)
+	assert.ErrorIs(t, b.Enqueue(2), ErrClosed)
+}
+
+func TestBlockingCloseDrains(t *testing.T) {
+	b := NewBlockingQueue[int](NewRingQueue[int](1))
+	ctx := context.Background()
+	assert.Nil(t, b.Put(ctx, 1))
+
+	producer := make(chan error)
+	go func() {
+		producer <- b.Put(ctx, 2)
+	}()
+	time.Sleep(10 * time.Millisecond)
+	b.Close()
+	assert.ErrorIs(t, <-producer, ErrClosed)
+
+	item, err := b.Take(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, item)
+	_, err = b.Take(ctx)
+	assert.ErrorIs(t, err, ErrClosed)
+}
+
+func TestBlockingSetMax(t *testing.T) {
+	b := NewBlockingQueue[int](NewRingQueue[int](1))
+	ctx := context.Background()
+	assert.Nil(t, b.Put(ctx, 1))
+	assert.True(t, b.IsFull())
+	assert.ErrorIs(t, b.Enqueue(2), ErrFull)
+
+	done := make(chan error)
+	go func() {
+		done <- b.Put(ctx, 2)
+	}()
+	time.Sleep(10 * time.Millisecond)
+	b.SetMax(2)
+	assert.Nil(t, <-done)
+	assert.Equal(t, "[1 2]", b.String())
+
+	item, err := b.Dequeue()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, item)
+	for _, item := range b.All() {
+		assert.Equal(t, 2, item)
+	}
+}
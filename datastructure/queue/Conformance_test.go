@@ -0,0 +1,32 @@
+package queue_test
+
+import (
+	"testing"
+
+	"github.com/OmarFaruk-0x01/go_algorithms/datastructure/containertest"
+	"github.com/OmarFaruk-0x01/go_algorithms/datastructure/queue"
+)
+
+// TestConformance runs the shared queue suite against every Queue of the package. It lives in the external test package because containertest imports queue.
+func TestConformance(t *testing.T) {
+	implementations := []struct {
+		name     string
+		newQueue func(max int) queue.Queue[int]
+	}{
+		{"SliceQueue", func(max int) queue.Queue[int] { q := queue.NewSliceQueue[int](max); return &q }},
+		{"StackQueue", func(max int) queue.Queue[int] { return queue.NewStackQueue[int](max) }},
+		{"RingQueue", func(max int) queue.Queue[int] { return queue.NewRingQueue[int](max) }},
+		{"LockFreeQueue", func(max int) queue.Queue[int] { return queue.NewLockFreeQueue[int](max) }},
+		{"SynchronizedQueue", func(max int) queue.Queue[int] {
+			return queue.NewSynchronizedQueue[int](queue.NewRingQueue[int](max))
+		}},
+		{"BlockingQueue", func(max int) queue.Queue[int] {
+			return queue.NewBlockingQueue[int](queue.NewStackQueue[int](max))
+		}},
+	}
+	for _, implementation := range implementations {
+		t.Run(implementation.name, func(t *testing.T) {
+			containertest.RunQueueSuite(t, implementation.newQueue)
+		})
+	}
+}
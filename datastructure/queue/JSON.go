@@ -0,0 +1,68 @@
+package queue
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/OmarFaruk-0x01/go_algorithms/datastructure/stack"
+)
+
+// jsonQueue is the JSON form shared by the queues: the max and the items from the front to the back.
+type jsonQueue[T any] struct {
+	Max   int `json:"max"`
+	Items []T `json:"items"`
+}
+
+// decodeJSONQueue decodes the JSON form of a queue. The max is required, since a queue without one would be full from the start, and may not be negative. The items are kept even if there are more than max of them, the same way SetMax keeps them when it shrinks a queue.
+func decodeJSONQueue[T any](data []byte) (jsonQueue[T], error) {
+	var decoded struct {
+		Max   *int `json:"max"`
+		Items []T  `json:"items"`
+	}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return jsonQueue[T]{}, err
+	}
+	if decoded.Max == nil {
+		return jsonQueue[T]{}, errors.New("queue JSON has no max")
+	}
+	if *decoded.Max < 0 {
+		return jsonQueue[T]{}, fmt.Errorf("queue JSON has a negative max %d", *decoded.Max)
+	}
+	if decoded.Items == nil {
+		decoded.Items = []T{}
+	}
+	return jsonQueue[T]{Max: *decoded.Max, Items: decoded.Items}, nil
+}
+
+// MarshalJSON encodes the queue as {"max":...,"items":[...]} with the items from the front to the back.
+func (s queue[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(jsonQueue[T]{Max: s.max, Items: s.queue})
+}
+
+// UnmarshalJSON replaces the max and the items of the queue with the ones of the JSON object MarshalJSON produces. An object without a max or with a negative one is rejected and the queue is left as it is.
+func (s *queue[T]) UnmarshalJSON(data []byte) error {
+	decoded, err := decodeJSONQueue[T](data)
+	if err != nil {
+		return err
+	}
+	*s = queue[T]{queue: decoded.Items, size: len(decoded.Items), max: decoded.Max}
+	return nil
+}
+
+// MarshalJSON encodes the queue as {"max":...,"items":[...]} with the items from the front to the back, in the same form as the slice queue.
+func (q stack_queue[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(jsonQueue[T]{Max: q.max, Items: slices.AppendSeq(make([]T, 0, q.Size()), q.Values())})
+}
+
+// UnmarshalJSON replaces the max and the items of the queue with the ones of the JSON object MarshalJSON produces, rejecting the same objects as the slice queue does. All items are put on the second stack, front on top, so they are dequeued without moving them first.
+func (q *stack_queue[T]) UnmarshalJSON(data []byte) error {
+	decoded, err := decodeJSONQueue[T](data)
+	if err != nil {
+		return err
+	}
+	slices.Reverse(decoded.Items)
+	*q = stack_queue[T]{stack_1: stack.New[T](), stack_2: stack.FromSlice(decoded.Items), max: decoded.Max}
+	return nil
+}
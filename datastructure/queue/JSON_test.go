@@ -0,0 +1,106 @@
+package queue
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type member struct {
+	Name string `json:"name"`
+	Age  uint   `json:"age"`
+}
+
+func TestMarshalJSON(t *testing.T) {
+	q, _ := Of(5, member{"Omar Faruk", 20}, member{"Tanvir Raj", 25}, member{"Sadik", 30})
+	q.Dequeue()
+	data, err := json.Marshal(q)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"max":5,"items":[{"name":"Tanvir Raj","age":25},{"name":"Sadik","age":30}]}`, string(data))
+
+	sq := NewStackQueue[member](5)
+	sq.Enqueue(member{"Omar Faruk", 20})
+	sq.Enqueue(member{"Tanvir Raj", 25})
+	sq.Dequeue()
+	sq.Enqueue(member{"Sadik", 30})
+	stackData, err := json.Marshal(sq)
+	assert.Nil(t, err)
+	assert.JSONEq(t, string(data), string(stackData))
+
+	empty, _ := json.Marshal(NewStackQueue[int](2))
+	assert.JSONEq(t, `{"max":2,"items":[]}`, string(empty))
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	data := []byte(`{"max":3,"items":[{"name":"a","age":1},{"name":"b","age":2}]}`)
+
+	q := NewSliceQueue[member](10)
+	assert.Nil(t, json.Unmarshal(data, &q))
+	assert.Equal(t, []member{{"a", 1}, {"b", 2}}, slices.Collect(q.Values()))
+	assert.Nil(t, q.Enqueue(member{"c", 3}))
+	assert.True(t, q.IsFull())
+
+	sq := NewStackQueue[member](10)
+	sq.Enqueue(member{"old", 0})
+	assert.Nil(t, json.Unmarshal(data, sq))
+	front, _ := sq.Dequeue()
+	assert.Equal(t, member{"a", 1}, front)
+	assert.Nil(t, sq.Enqueue(member{"c", 3}))
+	assert.Nil(t, sq.Enqueue(member{"d", 4}))
+	assert.True(t, sq.IsFull())
+	assert.Equal(t, []member{{"b", 2}, {"c", 3}, {"d", 4}}, slices.Collect(sq.Values()))
+
+	// A queue shrunk below its size by SetMax keeps its items through a round trip.
+	over := []byte(`{"max":1,"items":[1,2]}`)
+	small := NewSliceQueue[int](0)
+	assert.Nil(t, json.Unmarshal(over, &small))
+	assert.Equal(t, 2, small.Size())
+	assert.True(t, small.IsFull())
+
+	assert.Nil(t, json.Unmarshal([]byte(`{"max":2}`), &small))
+	assert.True(t, small.IsEmpty())
+	assert.Nil(t, small.Enqueue(1))
+	assert.NotNil(t, json.Unmarshal([]byte(`[1,2]`), &small))
+	assert.NotNil(t, json.Unmarshal([]byte(`[1,2]`), sq))
+}
+
+func TestUnmarshalJSONRequiresMax(t *testing.T) {
+	q, _ := Of(3, 7)
+	sq := NewStackQueue[int](3)
+	sq.Enqueue(7)
+	for _, data := range []string{`{"items":[1,2]}`, `{"max":null,"items":[1]}`, `{"max":-1,"items":[1]}`} {
+		assert.NotNil(t, json.Unmarshal([]byte(data), &q), data)
+		assert.NotNil(t, json.Unmarshal([]byte(data), sq), data)
+	}
+	assert.Equal(t, []int{7}, q.ToSlice())
+	assert.Nil(t, q.Enqueue(8))
+	assert.Equal(t, []int{7}, slices.Collect(sq.Values()))
+	assert.Nil(t, sq.Enqueue(8))
+
+	assert.Nil(t, json.Unmarshal([]byte(`{"max":0}`), &q))
+	assert.True(t, q.IsEmpty())
+	assert.True(t, q.IsFull())
+}
+
+func TestJSONRoundTripInStruct(t *testing.T) {
+	type jobs struct {
+		Pending queue[member]        `json:"pending"`
+		Retry   *stack_queue[member] `json:"retry"`
+	}
+	pending, _ := Of(4, member{"a", 1}, member{"b", 2})
+	retry := NewStackQueue[member](2)
+	retry.Enqueue(member{"c", 3})
+	original := jobs{Pending: pending, Retry: retry}
+
+	data, err := json.Marshal(original)
+	assert.Nil(t, err)
+	var decoded jobs
+	assert.Nil(t, json.Unmarshal(data, &decoded))
+	assert.True(t, Equal[member](&decoded.Pending, &original.Pending))
+	assert.True(t, Equal[member](decoded.Retry, original.Retry))
+	assert.Nil(t, decoded.Retry.Enqueue(member{"d", 4}))
+	assert.True(t, decoded.Retry.IsFull())
+	assert.Equal(t, 1, original.Retry.Size())
+}
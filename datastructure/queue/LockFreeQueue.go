@@ -0,0 +1,161 @@
+package queue
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"sync/atomic"
+)
+
+// lockFreeNode is a cell of the lock-free queue. Its value is set before the node is linked and only cleared by the Dequeue that turns the node into the sentinel, so the queue does not keep a dequeued item reachable.
+type lockFreeNode[T any] struct {
+	value atomic.Pointer[T]
+	next  atomic.Pointer[lockFreeNode[T]]
+}
+
+// lockFreeQueue is a Michael–Scott queue: producers link new nodes after the tail and consumers move the head with compare-and-swap, so many goroutines can enqueue and dequeue without a lock. The head always points to a sentinel node, the front item lives in the node after it.
+//
+// Fields:
+//
+//	head: The sentinel node, its successor holds the front item.
+//	tail: The last node or, while an enqueue is in flight, the node before it.
+//	size: The number of items including the ones being enqueued, used to enforce max.
+//	max: The maximum number of items the queue accepts.
+type lockFreeQueue[T any] struct {
+	head atomic.Pointer[lockFreeNode[T]]
+	tail atomic.Pointer[lockFreeNode[T]]
+	size atomic.Int64
+	max  atomic.Int64
+}
+
+// NewLockFreeQueue creates an empty lock-free queue that accepts up to maxItem items and can be shared between goroutines.
+func NewLockFreeQueue[T any](maxItem int) *lockFreeQueue[T] {
+	q := &lockFreeQueue[T]{}
+	sentinel := &lockFreeNode[T]{}
+	q.head.Store(sentinel)
+	q.tail.Store(sentinel)
+	q.max.Store(int64(maxItem))
+	return q
+}
+
+func (q *lockFreeQueue[T]) Enqueue(item T) error {
+	// Reserve a slot first so concurrent producers can never push the queue over max.
+	for {
+		size := q.size.Load()
+		if size >= q.max.Load() {
+			return ErrFull
+		}
+		if q.size.CompareAndSwap(size, size+1) {
+			break
+		}
+	}
+
+	newNode := &lockFreeNode[T]{}
+	newNode.value.Store(&item)
+	for {
+		tail := q.tail.Load()
+		next := tail.next.Load()
+		if tail != q.tail.Load() {
+			continue
+		}
+		if next != nil {
+			// Another producer linked its node but did not move the tail yet, help it.
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+		if tail.next.CompareAndSwap(nil, newNode) {
+			q.tail.CompareAndSwap(tail, newNode)
+			return nil
+		}
+	}
+}
+
+func (q *lockFreeQueue[T]) Dequeue() (T, error) {
+	for {
+		head := q.head.Load()
+		tail := q.tail.Load()
+		next := head.next.Load()
+		if head != q.head.Load() {
+			continue
+		}
+		if next == nil {
+			var _nil T
+			return _nil, ErrEmpty
+		}
+		if head == tail {
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+		if q.head.CompareAndSwap(head, next) {
+			// The item has left the queue, so its slot is released before anything else happens.
+			q.size.Add(-1)
+			// next is the sentinel now and no other goroutine takes its value.
+			return *next.value.Swap(nil), nil
+		}
+	}
+}
+
+func (q *lockFreeQueue[T]) Peek() (T, error) {
+	for {
+		next := q.head.Load().next.Load()
+		if next == nil {
+			var _nil T
+			return _nil, ErrEmpty
+		}
+		// A nil value means the item was dequeued in the meantime, look at the new front instead.
+		if value := next.value.Load(); value != nil {
+			return *value, nil
+		}
+	}
+}
+
+// Size returns the number of items. While other goroutines enqueue and dequeue it is only an approximation.
+func (q *lockFreeQueue[T]) Size() int {
+	return int(q.size.Load())
+}
+
+func (q *lockFreeQueue[T]) IsEmpty() bool {
+	return q.head.Load().next.Load() == nil
+}
+
+func (q *lockFreeQueue[T]) IsFull() bool {
+	return q.size.Load() >= q.max.Load()
+}
+
+// SetMax changes the maximum number of items. Items already in the queue are kept when it shrinks.
+func (q *lockFreeQueue[T]) SetMax(max int) {
+	q.max.Store(int64(max))
+}
+
+// All returns an iterator over the items of the queue from the front to the back. The iteration is weakly consistent: it never returns an item twice, but it may or may not see items enqueued or dequeued while it runs.
+func (q *lockFreeQueue[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		index := 0
+		for node := q.head.Load().next.Load(); node != nil; node = node.next.Load() {
+			value := node.value.Load()
+			if value == nil {
+				// Dequeued while the iteration ran.
+				continue
+			}
+			if !yield(index, *value) {
+				return
+			}
+			index++
+		}
+	}
+}
+
+// Values returns an iterator over the items of the queue from the front to the back with the same guarantees as All.
+func (q *lockFreeQueue[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, item := range q.All() {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+func (q *lockFreeQueue[T]) String() string {
+	return fmt.Sprintf("%v", slices.Collect(q.Values()))
+}
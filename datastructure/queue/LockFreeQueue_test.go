@@ -0,0 +1,184 @@
+package queue
+
+import (
+	"runtime"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLockFreeEnqueueDequeue(t *testing.T) {
+	q := NewLockFreeQueue[int](3)
+	_, err := q.Dequeue()
+	assert.ErrorIs(t, err, ErrEmpty)
+	_, err = q.Peek()
+	assert.ErrorIs(t, err, ErrEmpty)
+	assert.True(t, q.IsEmpty())
+
+	assert.Nil(t, q.Enqueue(1))
+	assert.Nil(t, q.Enqueue(2))
+	assert.Nil(t, q.Enqueue(3))
+	assert.True(t, q.IsFull())
+	assert.ErrorIs(t, q.Enqueue(4), ErrFull)
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(q.Values()))
+	assert.Equal(t, "[1 2 3]", q.String())
+
+	front, err := q.Peek()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, front)
+	front, err = q.Dequeue()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, front)
+	assert.Equal(t, 2, q.Size())
+
+	q.SetMax(4)
+	assert.Nil(t, q.Enqueue(4))
+	assert.Nil(t, q.Enqueue(5))
+	q.SetMax(1)
+	assert.True(t, q.IsFull())
+	assert.Equal(t, 4, q.Size())
+	expected, _ := Of(4, 2, 3, 4, 5)
+	assert.True(t, Equal[int](q, &expected))
+}
+
+func TestLockFreeDequeueReleasesItem(t *testing.T) {
+	q := NewLockFreeQueue[*int](3)
+	first, second := 1, 2
+	q.Enqueue(&first)
+	q.Enqueue(&second)
+
+	val, _ := q.Dequeue()
+	assert.Equal(t, &first, val)
+	assert.Nil(t, q.head.Load().value.Load())
+	front, _ := q.Peek()
+	assert.Equal(t, &second, front)
+	assert.Equal(t, []*int{&second}, slices.Collect(q.Values()))
+}
+
+// Every goroutine holds at most one item at a time and max is the number of goroutines, so an Enqueue can only fail if a dequeued item is still counted.
+func TestLockFreeEnqueueNeverFailsBelowMax(t *testing.T) {
+	const workers, rounds = 8, 5000
+	q := NewLockFreeQueue[int](workers)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < rounds; i++ {
+				if err := q.Enqueue(i); err != nil {
+					t.Errorf("enqueue %d failed below max: %v", i, err)
+					return
+				}
+				for {
+					if _, err := q.Dequeue(); err == nil {
+						break
+					}
+					runtime.Gosched()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.True(t, q.IsEmpty())
+	assert.Equal(t, 0, q.Size())
+}
+
+func TestLockFreeStress(t *testing.T) {
+	const producers, items = 8, 2000
+	q := NewLockFreeQueue[[2]int](256)
+
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			for i := 0; i < items; {
+				if q.Enqueue([2]int{p, i}) == nil {
+					i++
+				} else {
+					runtime.Gosched()
+				}
+			}
+		}(p)
+	}
+
+	var remaining atomic.Int64
+	remaining.Store(producers * items)
+	consumed := make(chan [][2]int, producers)
+	for c := 0; c < producers; c++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			mine := [][2]int{}
+			for remaining.Load() > 0 {
+				if item, err := q.Dequeue(); err == nil {
+					mine = append(mine, item)
+					remaining.Add(-1)
+				} else {
+					runtime.Gosched()
+				}
+				assert.LessOrEqual(t, q.Size(), 256)
+			}
+			consumed <- mine
+		}()
+	}
+	wg.Wait()
+	close(consumed)
+
+	assert.True(t, q.IsEmpty())
+	assert.Equal(t, 0, q.Size())
+	seen := make([][]int, producers)
+	for mine := range consumed {
+		// Every consumer must see the items of one producer in the order they were enqueued.
+		last := make([]int, producers)
+		for i := range last {
+			last[i] = -1
+		}
+		for _, item := range mine {
+			assert.Greater(t, item[1], last[item[0]])
+			last[item[0]] = item[1]
+			seen[item[0]] = append(seen[item[0]], item[1])
+		}
+	}
+	for _, items := range seen {
+		slices.Sort(items)
+		for i, item := range items {
+			assert.Equal(t, i, item)
+		}
+	}
+}
+
+func benchmarkConcurrentQueues(b *testing.B, run func(b *testing.B, q Queue[int])) {
+	implementations := []struct {
+		name     string
+		newQueue func() Queue[int]
+	}{
+		{"LockFree", func() Queue[int] { return NewLockFreeQueue[int](1 << 20) }},
+		{"SynchronizedSlice", func() Queue[int] { q := NewSliceQueue[int](1 << 20); return NewSynchronizedQueue[int](&q) }},
+		{"SynchronizedRing", func() Queue[int] { return NewSynchronizedQueue[int](NewRingQueue[int](1 << 20)) }},
+	}
+	for _, implementation := range implementations {
+		b.Run(implementation.name, func(b *testing.B) {
+			run(b, implementation.newQueue())
+		})
+	}
+}
+
+func BenchmarkConcurrentEnqueueDequeue(b *testing.B) {
+	benchmarkConcurrentQueues(b, func(b *testing.B, q Queue[int]) {
+		b.ReportAllocs()
+		b.RunParallel(func(pb *testing.PB) {
+			i := 0
+			for pb.Next() {
+				q.Enqueue(i)
+				q.Dequeue()
+				i++
+			}
+		})
+	})
+}
@@ -1,16 +1,25 @@
+// Package queue provides FIFO queues behind the Queue interface.
+//
+// NewSliceQueue and NewRingQueue are both bounded and single-goroutine, and they differ in how they store the items. The slice queue is the original one: it appends to a slice and reslices past the front on Dequeue, so it only allocates what it holds and is a plain value, but its backing array is compacted only when append has to grow it. The ring queue allocates max slots once and reuses them, which suits a long-lived queue that is never drained. The slice queue is kept, and not rebuilt on top of the ring buffer, because it is returned by value and its ToSlice hands out the backing slice without copying, which callers may rely on.
+//
+// NewSynchronizedQueue, NewBlockingQueue and NewLockFreeQueue can be shared between goroutines.
 package queue
 
 import (
-	"errors"
 	"fmt"
+	"iter"
+
+	"github.com/OmarFaruk-0x01/go_algorithms/datastructure/errs"
 )
 
-const (
-	queue_empty_error = "queue is empty"
-	queue_full_error  = "queue is full"
+var (
+	// ErrEmpty is returned when an item is read or removed from an empty queue.
+	ErrEmpty = errs.ErrEmpty
+	// ErrFull is returned when an item is added to a queue that reached its max.
+	ErrFull = errs.ErrFull
 )
 
-type Queue[T comparable] interface {
+type Queue[T any] interface {
 	Enqueue(item T) error
 	Dequeue() (T, error)
 	Peek() (T, error)
@@ -18,41 +27,59 @@ type Queue[T comparable] interface {
 	IsEmpty() bool
 	IsFull() bool
 	SetMax(max int)
+	All() iter.Seq2[int, T]
+	Values() iter.Seq[T]
 }
 
-type queue[T comparable] struct {
+type queue[T any] struct {
 	queue []T
 	size  int
 	max   int
 	_nil  T
 }
 
-func NewSliceQueue[T comparable](maxItem int) queue[T] {
+// NewSliceQueue creates an empty queue stored in a slice that accepts up to maxItem items.
+func NewSliceQueue[T any](maxItem int) queue[T] {
 	return queue[T]{queue: []T{}, size: 0, max: maxItem}
 }
 
+// FromSlice creates a slice queue holding the items of the given slice, the first item of the slice is the front of the queue. If there are more items than maxItem it will return a error. The slice itself is not retained.
+func FromSlice[T any](maxItem int, items []T) (queue[T], error) {
+	if len(items) > maxItem {
+		return NewSliceQueue[T](maxItem), ErrFull
+	}
+	return queue[T]{queue: append([]T{}, items...), size: len(items), max: maxItem}, nil
+}
+
+// Of creates a slice queue holding the given items, the first item is the front of the queue. If there are more items than maxItem it will return a error.
+func Of[T any](maxItem int, items ...T) (queue[T], error) {
+	return FromSlice(maxItem, items)
+}
+
 func (s *queue[T]) Enqueue(item T) error {
 	if s.IsFull() {
-		return errors.New(queue_full_error)
+		return ErrFull
 	}
 	s.queue = append(s.queue, item)
 	s.size++
 	return nil
 }
 
+// Dequeue removes and returns the front item. Its slot is zeroed before the slice moves past it, so the queue does not keep what the item references alive. The slots before the front are released as well the next time Enqueue outgrows the backing array, since append copies only the items left.
 func (s *queue[T]) Dequeue() (T, error) {
 	if s.IsEmpty() {
-		return s._nil, errors.New(queue_empty_error)
+		return s._nil, ErrEmpty
 	}
 	top := s.queue[0]
-	s.queue = s.queue[1:len(s.queue)]
+	s.queue[0] = s._nil
+	s.queue = s.queue[1:]
 	s.size--
 	return top, nil
 }
 
 func (s *queue[T]) Peek() (T, error) {
 	if s.IsEmpty() {
-		return s._nil, errors.New(queue_empty_error)
+		return s._nil, ErrEmpty
 	}
 	return s.queue[0], nil
 }
@@ -66,9 +93,10 @@ func (s *queue[T]) Size() int {
 }
 
 func (s *queue[T]) IsFull() bool {
-	return s.max == s.size
+	return s.size >= s.max
 }
 
+// SetMax changes the maximum number of items. Items already in the queue are kept when it shrinks.
 func (s *queue[T]) SetMax(max int) {
 	s.max = max
 }
@@ -77,6 +105,73 @@ func (s *queue[T]) ToSlice() []T {
 	return s.queue
 }
 
+// Clone creates a copy of the queue with the same max that does not share its storage with the original.
+func (s *queue[T]) Clone() queue[T] {
+	return queue[T]{queue: append([]T{}, s.queue...), size: s.size, max: s.max}
+}
+
+// EqualFunc reports whether the other queue holds the same items in the same order from the front to the back, comparing the items with the given function. The max of the queues is not compared.
+func (s *queue[T]) EqualFunc(other Queue[T], equal func(a, b T) bool) bool {
+	if s.size != other.Size() {
+		return false
+	}
+	i := 0
+	for item := range other.Values() {
+		if i >= len(s.queue) || !equal(s.queue[i], item) {
+			return false
+		}
+		i++
+	}
+	return true
+}
+
+// All returns an iterator over the items of the queue from the front to the back, in the order Dequeue would return them.
+func (s *queue[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, item := range s.queue {
+			if !yield(i, item) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the items of the queue from the front to the back.
+func (s *queue[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, item := range s.queue {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Equal reports whether two queues of comparable items hold the same items in the same order. The max of the queues is not compared.
+func Equal[T comparable](q Queue[T], other Queue[T]) bool {
+	return EqualFunc(q, other, func(a, b T) bool { return a == b })
+}
+
+// EqualFunc reports whether two queues hold the same items in the same order from the front to the back, comparing the items with the given function. A queue with an EqualFunc method compares with it, any other queue is walked alongside the other one. The max of the queues is not compared.
+func EqualFunc[T any](q Queue[T], other Queue[T], equal func(a, b T) bool) bool {
+	if comparer, ok := q.(interface {
+		EqualFunc(other Queue[T], equal func(a, b T) bool) bool
+	}); ok {
+		return comparer.EqualFunc(other, equal)
+	}
+	if q.Size() != other.Size() {
+		return false
+	}
+	next, stop := iter.Pull(other.Values())
+	defer stop()
+	for item := range q.Values() {
+		if value, ok := next(); !ok || !equal(item, value) {
+			return false
+		}
+	}
+	return true
+}
+
 func (s queue[T]) String() string {
 	return fmt.Sprintf("%v", s.queue)
 }
@@ -1,8 +1,12 @@
 package queue
 
 import (
+	"fmt"
+	"slices"
 	"testing"
 
+	"github.com/OmarFaruk-0x01/go_algorithms/datastructure/errs"
+	"github.com/OmarFaruk-0x01/go_algorithms/datastructure/stack"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -39,3 +43,296 @@ func TestDequeue(t *testing.T) {
 	_, err = q.Dequeue()
 	assert.NotNil(t, err)
 }
+
+func TestDequeueReleasesItem(t *testing.T) {
+	q := NewSliceQueue[*int](3)
+	first, second := 1, 2
+	q.Enqueue(&first)
+	q.Enqueue(&second)
+	backing := q.queue
+
+	val, _ := q.Dequeue()
+	assert.Equal(t, &first, val)
+	assert.Nil(t, backing[0])
+	assert.Equal(t, []*int{&second}, q.ToSlice())
+}
+
+func TestAll(t *testing.T) {
+	q := NewSliceQueue[int](5)
+	q.Enqueue(20)
+	q.Enqueue(30)
+	q.Enqueue(10)
+	q.Dequeue()
+
+	indexes := []int{}
+	items := []int{}
+	for index, item := range q.All() {
+		indexes = append(indexes, index)
+		items = append(items, item)
+	}
+	assert.Equal(t, []int{0, 1}, indexes)
+	assert.Equal(t, []int{30, 10}, items)
+	assert.Equal(t, []int{30, 10}, slices.Collect(q.Values()))
+}
+
+func TestStackQueueAll(t *testing.T) {
+	q := NewStackQueue[int](5)
+	q.Enqueue(1)
+	q.Enqueue(2)
+	q.Enqueue(3)
+	q.Dequeue()
+	q.Enqueue(4)
+	q.Enqueue(5)
+
+	indexes := []int{}
+	items := []int{}
+	for index, item := range q.All() {
+		indexes = append(indexes, index)
+		items = append(items, item)
+	}
+	assert.Equal(t, []int{0, 1, 2, 3}, indexes)
+	assert.Equal(t, []int{2, 3, 4, 5}, items)
+
+	items = []int{}
+	for item := range q.Values() {
+		if item == 4 {
+			break
+		}
+		items = append(items, item)
+	}
+	assert.Equal(t, []int{2, 3}, items)
+}
+
+func TestFromSlice(t *testing.T) {
+	items := []int{1, 2, 3}
+	q, err := FromSlice(3, items)
+	assert.Nil(t, err)
+	items[0] = 100
+	assert.True(t, q.IsFull())
+
+	val, err := q.Dequeue()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, val)
+	assert.Nil(t, q.Enqueue(4))
+	assert.Equal(t, []int{2, 3, 4}, slices.Collect(q.Values()))
+
+	_, err = FromSlice(2, []int{1, 2, 3})
+	assert.NotNil(t, err)
+
+	letters, err := Of(5, "a", "b")
+	assert.Nil(t, err)
+	front, _ := letters.Peek()
+	assert.Equal(t, "a", front)
+	assert.Equal(t, 2, letters.Size())
+}
+
+func TestCloneEqual(t *testing.T) {
+	q, _ := Of(5, 1, 2, 3)
+	clone := q.Clone()
+	assert.True(t, Equal[int](&q, &clone))
+
+	clone.Dequeue()
+	assert.False(t, Equal[int](&q, &clone))
+	assert.Equal(t, 3, q.Size())
+	clone.Enqueue(4)
+	assert.False(t, Equal[int](&q, &clone))
+
+	clone.SetMax(3)
+	assert.True(t, clone.IsFull())
+	assert.False(t, q.IsFull())
+}
+
+func TestEqualFunc(t *testing.T) {
+	sameLength := func(a, b string) bool { return len(a) == len(b) }
+	q, _ := Of(5, "Omar", "Faruk")
+	lockFree := NewLockFreeQueue[string](5)
+	lockFree.Enqueue("Sadi")
+	lockFree.Enqueue("Ahmad")
+	// The lock-free queue has no EqualFunc method, so it is walked alongside the other queue.
+	assert.True(t, EqualFunc[string](lockFree, &q, sameLength))
+	assert.True(t, EqualFunc[string](&q, lockFree, sameLength))
+	assert.False(t, Equal[string](lockFree, &q))
+
+	lockFree.Dequeue()
+	assert.False(t, EqualFunc[string](lockFree, &q, sameLength))
+}
+
+func TestErrors(t *testing.T) {
+	q := NewSliceQueue[int](1)
+	_, err := q.Dequeue()
+	assert.ErrorIs(t, err, ErrEmpty)
+	_, err = q.Peek()
+	assert.ErrorIs(t, err, ErrEmpty)
+	q.Enqueue(1)
+	assert.ErrorIs(t, q.Enqueue(2), ErrFull)
+	assert.ErrorIs(t, q.Enqueue(2), errs.ErrFull)
+	_, err = FromSlice(1, []int{1, 2})
+	assert.ErrorIs(t, err, ErrFull)
+
+	sq := NewStackQueue[int](1)
+	_, err = sq.Dequeue()
+	assert.ErrorIs(t, err, ErrEmpty)
+	_, err = sq.Peek()
+	assert.ErrorIs(t, err, stack.ErrEmpty)
+}
+
+func TestAnyItemType(t *testing.T) {
+	type job struct {
+		name string
+		args []string
+	}
+	q := NewSliceQueue[job](2)
+	assert.Nil(t, q.Enqueue(job{name: "build", args: []string{"./..."}}))
+	assert.Nil(t, q.Enqueue(job{name: "test", args: []string{"-race"}}))
+
+	other, _ := Of(2, job{name: "build"}, job{name: "test"})
+	assert.True(t, q.EqualFunc(&other, func(a, b job) bool { return a.name == b.name }))
+
+	front, err := q.Dequeue()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"./..."}, front.args)
+}
+
+// benchmarkSizes are the queue sizes every method is measured at, so the growth of the timings shows the complexity of the method.
+var benchmarkSizes = []int{10, 100, 1000, 10000}
+
+// BenchmarkQueue measures every Queue method of the slice, stack and ring queues at every benchmark size. Enqueue and Dequeue are paired with the opposite operation, so the queue keeps its size across iterations.
+func BenchmarkQueue(b *testing.B) {
+	implementations := []struct {
+		name     string
+		newQueue func(max int) Queue[int]
+	}{
+		{"SliceQueue", func(max int) Queue[int] { q := NewSliceQueue[int](max); return &q }},
+		{"StackQueue", func(max int) Queue[int] { return NewStackQueue[int](max) }},
+		{"RingQueue", func(max int) Queue[int] { return NewRingQueue[int](max) }},
+	}
+	methods := []struct {
+		name string
+		run  func(b *testing.B, q Queue[int])
+	}{
+		{"Enqueue", func(b *testing.B, q Queue[int]) {
+			for i := 0; i < b.N; i++ {
+				q.Enqueue(i)
+				q.Dequeue()
+			}
+		}},
+		{"Dequeue", func(b *testing.B, q Queue[int]) {
+			for i := 0; i < b.N; i++ {
+				q.Dequeue()
+				q.Enqueue(i)
+			}
+		}},
+		{"Peek", func(b *testing.B, q Queue[int]) {
+			for i := 0; i < b.N; i++ {
+				q.Peek()
+			}
+		}},
+		{"Size", func(b *testing.B, q Queue[int]) {
+			for i := 0; i < b.N; i++ {
+				q.Size()
+			}
+		}},
+		{"IsEmpty", func(b *testing.B, q Queue[int]) {
+			for i := 0; i < b.N; i++ {
+				q.IsEmpty()
+			}
+		}},
+		{"IsFull", func(b *testing.B, q Queue[int]) {
+			for i := 0; i < b.N; i++ {
+				q.IsFull()
+			}
+		}},
+		{"SetMax", func(b *testing.B, q Queue[int]) {
+			max := q.Size() + 1
+			for i := 0; i < b.N; i++ {
+				q.SetMax(max + i%2)
+			}
+		}},
+		{"All", func(b *testing.B, q Queue[int]) {
+			for i := 0; i < b.N; i++ {
+				for range q.All() {
+				}
+			}
+		}},
+		{"Values", func(b *testing.B, q Queue[int]) {
+			for i := 0; i < b.N; i++ {
+				for range q.Values() {
+				}
+			}
+		}},
+	}
+	for _, implementation := range implementations {
+		for _, method := range methods {
+			for _, size := range benchmarkSizes {
+				b.Run(fmt.Sprintf("%s/%s/size=%d", implementation.name, method.name, size), func(b *testing.B) {
+					q := implementation.newQueue(size + 1)
+					for i := 0; i < size; i++ {
+						q.Enqueue(i)
+					}
+					b.ReportAllocs()
+					b.ResetTimer()
+					method.run(b, q)
+				})
+			}
+		}
+	}
+}
+
+// copyableQueue is the method set the slice and ring queues add to Queue.
+type copyableQueue interface {
+	Queue[int]
+	ToSlice() []int
+	EqualFunc(other Queue[int], equal func(a, b int) bool) bool
+}
+
+// BenchmarkQueueCopies measures the methods the slice and ring queues have beyond Queue at every benchmark size.
+func BenchmarkQueueCopies(b *testing.B) {
+	implementations := []struct {
+		name     string
+		newQueue func(max int) copyableQueue
+		clone    func(q copyableQueue)
+	}{
+		{"SliceQueue", func(max int) copyableQueue { q := NewSliceQueue[int](max); return &q }, func(q copyableQueue) { q.(*queue[int]).Clone() }},
+		{"RingQueue", func(max int) copyableQueue { return NewRingQueue[int](max) }, func(q copyableQueue) { q.(*ringQueue[int]).Clone() }},
+	}
+	for _, implementation := range implementations {
+		methods := []struct {
+			name string
+			run  func(b *testing.B, q copyableQueue)
+		}{
+			{"ToSlice", func(b *testing.B, q copyableQueue) {
+				for i := 0; i < b.N; i++ {
+					q.ToSlice()
+				}
+			}},
+			{"Clone", func(b *testing.B, q copyableQueue) {
+				for i := 0; i < b.N; i++ {
+					implementation.clone(q)
+				}
+			}},
+			{"EqualFunc", func(b *testing.B, q copyableQueue) {
+				other := NewRingQueue[int](q.Size())
+				for item := range q.Values() {
+					other.Enqueue(item)
+				}
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					q.EqualFunc(other, func(a, b int) bool { return a == b })
+				}
+			}},
+		}
+		for _, method := range methods {
+			for _, size := range benchmarkSizes {
+				b.Run(fmt.Sprintf("%s/%s/size=%d", implementation.name, method.name, size), func(b *testing.B) {
+					q := implementation.newQueue(size + 1)
+					for i := 0; i < size; i++ {
+						q.Enqueue(i)
+					}
+					b.ReportAllocs()
+					b.ResetTimer()
+					method.run(b, q)
+				})
+			}
+		}
+	}
+}
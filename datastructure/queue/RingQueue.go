@@ -0,0 +1,145 @@
+package queue
+
+import (
+	"fmt"
+	"iter"
+)
+
+// ringQueue is a bounded queue stored in a circular buffer. The buffer is allocated once for max items and reused, so dequeued items do not keep the backing array growing and their slots are zeroed to release what they reference.
+//
+// Fields:
+//
+//	buffer: The circular buffer, its length is the capacity of the queue.
+//	head: The index of the front item inside the buffer.
+//	size: The number of items stored in the queue.
+//	max: The maximum number of items the queue accepts.
+type ringQueue[T any] struct {
+	buffer []T
+	head   int
+	size   int
+	max    int
+	_nil   T
+}
+
+// NewRingQueue creates a queue backed by a circular buffer of maxItem slots.
+func NewRingQueue[T any](maxItem int) *ringQueue[T] {
+	if maxItem < 0 {
+		maxItem = 0
+	}
+	return &ringQueue[T]{buffer: make([]T, maxItem), max: maxItem}
+}
+
+func (q *ringQueue[T]) Enqueue(item T) error {
+	if q.IsFull() {
+		return ErrFull
+	}
+	q.buffer[q.index(q.size)] = item
+	q.size++
+	return nil
+}
+
+func (q *ringQueue[T]) Dequeue() (T, error) {
+	if q.IsEmpty() {
+		return q._nil, ErrEmpty
+	}
+	front := q.buffer[q.head]
+	q.buffer[q.head] = q._nil
+	q.head = q.index(1)
+	q.size--
+	return front, nil
+}
+
+func (q *ringQueue[T]) Peek() (T, error) {
+	if q.IsEmpty() {
+		return q._nil, ErrEmpty
+	}
+	return q.buffer[q.head], nil
+}
+
+func (q *ringQueue[T]) IsEmpty() bool {
+	return q.size == 0
+}
+
+func (q *ringQueue[T]) Size() int {
+	return q.size
+}
+
+func (q *ringQueue[T]) IsFull() bool {
+	return q.size >= q.max
+}
+
+// SetMax changes the capacity of the queue and moves the items into a buffer of the new size. Shrinking below the current size keeps every item, the queue just reports full until enough items are dequeued.
+func (q *ringQueue[T]) SetMax(maxItem int) {
+	maxItem = max(maxItem, 0)
+	buffer := make([]T, max(maxItem, q.size))
+	for i := 0; i < q.size; i++ {
+		buffer[i] = q.buffer[q.index(i)]
+	}
+	q.buffer = buffer
+	q.head = 0
+	q.max = maxItem
+}
+
+// ToSlice creates a copy of the items of the queue from the front to the back.
+func (q *ringQueue[T]) ToSlice() []T {
+	items := make([]T, 0, q.size)
+	for i := 0; i < q.size; i++ {
+		items = append(items, q.buffer[q.index(i)])
+	}
+	return items
+}
+
+// Clone creates a copy of the queue with the same max that does not share its buffer with the original.
+func (q *ringQueue[T]) Clone() *ringQueue[T] {
+	clone := &ringQueue[T]{buffer: make([]T, len(q.buffer)), size: q.size, max: q.max}
+	// The items may wrap around the end of the buffer, the clone starts them at its front.
+	n := copy(clone.buffer, q.buffer[q.head:min(q.head+q.size, len(q.buffer))])
+	copy(clone.buffer[n:], q.buffer[:q.size-n])
+	return clone
+}
+
+// EqualFunc reports whether the other queue holds the same items in the same order from the front to the back, comparing the items with the given function. The max of the queues is not compared.
+func (q *ringQueue[T]) EqualFunc(other Queue[T], equal func(a, b T) bool) bool {
+	if q.size != other.Size() {
+		return false
+	}
+	i := 0
+	for item := range other.Values() {
+		if i >= q.size || !equal(q.buffer[q.index(i)], item) {
+			return false
+		}
+		i++
+	}
+	return true
+}
+
+// All returns an iterator over the items of the queue from the front to the back, in the order Dequeue would return them.
+func (q *ringQueue[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := 0; i < q.size; i++ {
+			if !yield(i, q.buffer[q.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the items of the queue from the front to the back.
+func (q *ringQueue[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < q.size; i++ {
+			if !yield(q.buffer[q.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+func (q ringQueue[T]) String() string {
+	return fmt.Sprintf("%v", q.ToSlice())
+}
+
+// index converts the position of an item counted from the front of the queue to its index inside the buffer.
+func (q *ringQueue[T]) index(position int) int {
+	return (q.head + position) % len(q.buffer)
+}
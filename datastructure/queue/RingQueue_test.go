@@ -0,0 +1,171 @@
+package queue
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRingQueueEnqueueDequeue(t *testing.T) {
+	q := NewRingQueue[int](3)
+	_, err := q.Dequeue()
+	assert.ErrorIs(t, err, ErrEmpty)
+
+	for round := 0; round < 4; round++ {
+		assert.Nil(t, q.Enqueue(round*10+1))
+		assert.Nil(t, q.Enqueue(round*10+2))
+		assert.Nil(t, q.Enqueue(round*10+3))
+		assert.True(t, q.IsFull())
+		assert.ErrorIs(t, q.Enqueue(100), ErrFull)
+
+		front, err := q.Peek()
+		assert.Nil(t, err)
+		assert.Equal(t, round*10+1, front)
+		val, _ := q.Dequeue()
+		assert.Equal(t, round*10+1, val)
+		assert.Nil(t, q.Enqueue(round*10+4))
+		assert.Equal(t, []int{round*10 + 2, round*10 + 3, round*10 + 4}, q.ToSlice())
+
+		for !q.IsEmpty() {
+			q.Dequeue()
+		}
+	}
+	assert.Equal(t, 0, q.Size())
+}
+
+func TestRingQueueZeroesFreedSlots(t *testing.T) {
+	q := NewRingQueue[*int](2)
+	one, two := 1, 2
+	q.Enqueue(&one)
+	q.Enqueue(&two)
+	q.Dequeue()
+	assert.Nil(t, q.buffer[0])
+	q.Dequeue()
+	assert.Equal(t, []*int{nil, nil}, q.buffer)
+}
+
+func TestRingQueueSetMax(t *testing.T) {
+	q := NewRingQueue[int](4)
+	for i := 1; i <= 4; i++ {
+		q.Enqueue(i)
+	}
+	q.Dequeue()
+	q.Dequeue()
+	q.Enqueue(5)
+	q.Enqueue(6)
+
+	q.SetMax(6)
+	assert.False(t, q.IsFull())
+	assert.Nil(t, q.Enqueue(7))
+	assert.Nil(t, q.Enqueue(8))
+	assert.True(t, q.IsFull())
+	assert.Equal(t, []int{3, 4, 5, 6, 7, 8}, q.ToSlice())
+
+	q.SetMax(2)
+	assert.True(t, q.IsFull())
+	assert.Equal(t, 6, q.Size())
+	assert.ErrorIs(t, q.Enqueue(9), ErrFull)
+	for i := 0; i < 5; i++ {
+		q.Dequeue()
+	}
+	assert.False(t, q.IsFull())
+	assert.Nil(t, q.Enqueue(9))
+	assert.True(t, q.IsFull())
+	assert.Equal(t, []int{8, 9}, slices.Collect(q.Values()))
+
+	q.SetMax(0)
+	assert.True(t, q.IsFull())
+	q.Dequeue()
+	q.Dequeue()
+	assert.True(t, q.IsEmpty())
+	assert.ErrorIs(t, q.Enqueue(10), ErrFull)
+}
+
+func TestRingQueueCloneEqual(t *testing.T) {
+	q := NewRingQueue[int](3)
+	q.Enqueue(1)
+	q.Enqueue(2)
+	q.Dequeue()
+	q.Enqueue(3)
+	q.Enqueue(4)
+
+	clone := q.Clone()
+	assert.Equal(t, []int{2, 3, 4}, clone.buffer)
+	assert.True(t, Equal[int](q, clone))
+	clone.Dequeue()
+	assert.False(t, Equal[int](q, clone))
+	assert.Equal(t, []int{2, 3, 4}, q.ToSlice())
+
+	sliceQueue, _ := Of(5, 2, 3, 4)
+	assert.True(t, q.EqualFunc(&sliceQueue, func(a, b int) bool { return a == b }))
+	assert.Equal(t, "[2 3 4]", fmt.Sprint(*q))
+
+	// A queue shrunk below its size keeps a buffer larger than its max.
+	q.SetMax(1)
+	q.Dequeue()
+	q.Dequeue()
+	clone = q.Clone()
+	assert.Equal(t, []int{4, 0, 0}, clone.buffer)
+	assert.Equal(t, 1, clone.max)
+	assert.True(t, clone.IsFull())
+}
+
+// fifo is the part of a queue the benchmarks need, it is implemented by every queue of the package.
+type fifo[T any] interface {
+	Enqueue(item T) error
+	Dequeue() (T, error)
+}
+
+func benchmarkQueues(b *testing.B, run func(b *testing.B, newQueue func(max int) fifo[int])) {
+	implementations := []struct {
+		name     string
+		newQueue func(max int) fifo[int]
+	}{
+		{"SliceQueue", func(max int) fifo[int] { q := NewSliceQueue[int](max); return &q }},
+		{"StackQueue", func(max int) fifo[int] { return NewStackQueue[int](max) }},
+		{"RingQueue", func(max int) fifo[int] { return NewRingQueue[int](max) }},
+	}
+	for _, implementation := range implementations {
+		b.Run(implementation.name, func(b *testing.B) {
+			run(b, implementation.newQueue)
+		})
+	}
+}
+
+func BenchmarkQueueSteadyState(b *testing.B) {
+	benchmarkQueues(b, func(b *testing.B, newQueue func(max int) fifo[int]) {
+		for _, size := range []int{16, 1024} {
+			b.Run(fmt.Sprintf("size=%d", size), func(b *testing.B) {
+				q := newQueue(size)
+				for i := 0; i < size/2; i++ {
+					q.Enqueue(i)
+				}
+				b.ReportAllocs()
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					q.Enqueue(i)
+					q.Dequeue()
+				}
+			})
+		}
+	})
+}
+
+func BenchmarkQueueFillDrain(b *testing.B) {
+	const size = 1024
+	benchmarkQueues(b, func(b *testing.B, newQueue func(max int) fifo[int]) {
+		q := newQueue(size)
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			for j := 0; j < size; j++ {
+				q.Enqueue(j)
+			}
+			for j := 0; j < size; j++ {
+				q.Dequeue()
+			}
+		}
+	})
+}
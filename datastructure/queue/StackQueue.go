@@ -1,27 +1,35 @@
 package queue
 
 import (
-	"errors"
 	"fmt"
+	"iter"
 
 	"github.com/OmarFaruk-0x01/go_algorithms/datastructure/stack"
 )
 
-type stack_queue[T comparable] struct {
+// stack_queue is a queue built from two stacks: items are pushed on the first one and popped from the second one, which is refilled from the first one whenever it runs empty, so every item is moved once and Dequeue is amortized O(1).
+//
+// Fields:
+//
+//	stack_1: Holds the back of the queue, its top is the last item enqueued.
+//	stack_2: Holds the front of the queue, its top is the next item to dequeue.
+//	max: The maximum number of items both stacks hold together.
+type stack_queue[T any] struct {
 	stack_1 stack.Stack[T]
 	stack_2 stack.Stack[T]
 	max     int
 }
 
-func NewStackQueue[T comparable](maxItem int) *stack_queue[T] {
+// NewStackQueue creates an empty queue of two stacks that accepts up to maxItem items.
+func NewStackQueue[T any](maxItem int) *stack_queue[T] {
 	stack1 := stack.New[T]()
 	stack2 := stack.New[T]()
 	return &stack_queue[T]{stack_1: stack1, stack_2: stack2, max: maxItem}
 }
 
 func (q *stack_queue[T]) Enqueue(item T) error {
-	if q.stack_1.Size() > q.max || q.stack_2.Size() > q.max {
-		return errors.New(queue_full_error)
+	if q.IsFull() {
+		return ErrFull
 	}
 	q.stack_1.Push(item)
 	return nil
@@ -30,7 +38,7 @@ func (q *stack_queue[T]) Enqueue(item T) error {
 func (q *stack_queue[T]) Dequeue() (T, error) {
 	if q.IsEmpty() {
 		var nil T
-		return nil, errors.New(queue_empty_error)
+		return nil, ErrEmpty
 	}
 	if q.stack_2.IsEmpty() {
 		moveStackData(q.stack_1, q.stack_2)
@@ -42,7 +50,7 @@ func (q *stack_queue[T]) Dequeue() (T, error) {
 func (q *stack_queue[T]) Peek() (T, error) {
 	if q.IsEmpty() {
 		var nil T
-		return nil, errors.New(queue_empty_error)
+		return nil, ErrEmpty
 	}
 	if q.stack_2.IsEmpty() {
 		moveStackData(q.stack_1, q.stack_2)
@@ -59,15 +67,54 @@ func (q *stack_queue[T]) Size() int {
 	return q.stack_1.Size() + q.stack_2.Size()
 }
 
+func (q *stack_queue[T]) IsFull() bool {
+	return q.Size() >= q.max
+}
+
+// SetMax changes the maximum number of items. Items already in the queue are kept when it shrinks.
 func (q *stack_queue[T]) SetMax(max int) {
 	q.max = max
 }
 
+// All returns an iterator over the items of the queue from the front to the back. The front of the queue lives on top of the second stack while the back lives on top of the first one, so the first stack is copied to walk it from the bottom.
+func (q *stack_queue[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		index := 0
+		for item := range q.stack_2.Values() {
+			if !yield(index, item) {
+				return
+			}
+			index++
+		}
+		back := make([]T, 0, q.stack_1.Size())
+		for item := range q.stack_1.Values() {
+			back = append(back, item)
+		}
+		for i := len(back) - 1; i >= 0; i-- {
+			if !yield(index, back[i]) {
+				return
+			}
+			index++
+		}
+	}
+}
+
+// Values returns an iterator over the items of the queue from the front to the back.
+func (q *stack_queue[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, item := range q.All() {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
 func (q stack_queue[T]) String() string {
 	return fmt.Sprintf("stack1: %v stack2: %v", q.stack_1, q.stack_2)
 }
 
-func moveStackData[T comparable](stack1 stack.Stack[T], stack2 stack.Stack[T]) {
+func moveStackData[T any](stack1 stack.Stack[T], stack2 stack.Stack[T]) {
 	for !stack1.IsEmpty() {
 		item, _ := stack1.Pop()
 		stack2.Push(item)
@@ -0,0 +1,115 @@
+package queue
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"sync"
+)
+
+// syncQueue guards another Queue with a mutex so it can be shared between goroutines. Every method holds the lock for its whole duration, which also makes the compound operations like DequeueIfPresent atomic.
+//
+// Fields:
+//
+//	mu: The mutex guarding the wrapped queue.
+//	queue: The wrapped queue, it must not be used directly once wrapped.
+type syncQueue[T any] struct {
+	mu    sync.Mutex
+	queue Queue[T]
+}
+
+// NewSynchronizedQueue wraps the given queue so it can be used from many goroutines at once.
+func NewSynchronizedQueue[T any](q Queue[T]) *syncQueue[T] {
+	return &syncQueue[T]{queue: q}
+}
+
+func (q *syncQueue[T]) Enqueue(item T) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.queue.Enqueue(item)
+}
+
+func (q *syncQueue[T]) Dequeue() (T, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.queue.Dequeue()
+}
+
+func (q *syncQueue[T]) Peek() (T, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.queue.Peek()
+}
+
+func (q *syncQueue[T]) Size() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.queue.Size()
+}
+
+func (q *syncQueue[T]) IsEmpty() bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.queue.IsEmpty()
+}
+
+func (q *syncQueue[T]) IsFull() bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.queue.IsFull()
+}
+
+func (q *syncQueue[T]) SetMax(max int) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.queue.SetMax(max)
+}
+
+// DequeueIfPresent dequeues the front item if there is one. Unlike checking IsEmpty before calling Dequeue, no other goroutine can empty the queue in between.
+func (q *syncQueue[T]) DequeueIfPresent() (T, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	item, err := q.queue.Dequeue()
+	return item, err == nil
+}
+
+// DequeueIf dequeues the front item only if the predicate returns true for it.
+func (q *syncQueue[T]) DequeueIf(predicate func(item T) bool) (T, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	item, err := q.queue.Peek()
+	if err != nil || !predicate(item) {
+		var _nil T
+		return _nil, false
+	}
+	q.queue.Dequeue()
+	return item, true
+}
+
+// Atomic runs the given function with exclusive access to the wrapped queue, so several operations happen without any other goroutine seeing the queue in between. The queue must not be used after the function returns.
+func (q *syncQueue[T]) Atomic(operation func(queue Queue[T])) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	operation(q.queue)
+}
+
+// All returns an iterator over a snapshot of the items of the queue from the front to the back. The snapshot is taken under the lock, so the loop body can use the queue freely.
+func (q *syncQueue[T]) All() iter.Seq2[int, T] {
+	return slices.All(q.snapshot())
+}
+
+// Values returns an iterator over a snapshot of the items of the queue from the front to the back.
+func (q *syncQueue[T]) Values() iter.Seq[T] {
+	return slices.Values(q.snapshot())
+}
+
+func (q *syncQueue[T]) String() string {
+	return fmt.Sprintf("%v", q.snapshot())
+}
+
+// snapshot copies the items of the wrapped queue from the front to the back.
+func (q *syncQueue[T]) snapshot() []T {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return slices.Collect(q.queue.Values())
+}
@@ -0,0 +1,114 @@
+package queue
+
+import (
+	"runtime"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSynchronizedEnqueueDequeue(t *testing.T) {
+	const producers, items = 8, 1000
+	q := NewSynchronizedQueue[int](NewRingQueue[int](64))
+
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			for i := 0; i < items; {
+				if q.Enqueue(p*items+i) == nil {
+					i++
+				} else {
+					runtime.Gosched()
+				}
+			}
+		}(p)
+	}
+
+	var remaining atomic.Int64
+	remaining.Store(producers * items)
+	consumed := make(chan []int, producers)
+	for c := 0; c < producers; c++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			items := []int{}
+			for remaining.Load() > 0 {
+				if item, ok := q.DequeueIfPresent(); ok {
+					items = append(items, item)
+					remaining.Add(-1)
+				} else {
+					runtime.Gosched()
+				}
+			}
+			consumed <- items
+		}()
+	}
+	wg.Wait()
+	close(consumed)
+
+	assert.True(t, q.IsEmpty())
+	all := []int{}
+	for items := range consumed {
+		all = append(all, items...)
+	}
+	slices.Sort(all)
+	for i, item := range all {
+		assert.Equal(t, i, item)
+	}
+}
+
+func TestSynchronizedFIFOPerProducer(t *testing.T) {
+	const producers, items = 4, 500
+	q := NewSynchronizedQueue[[2]int](NewRingQueue[[2]int](producers * items))
+
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			for i := 0; i < items; i++ {
+				assert.Nil(t, q.Enqueue([2]int{p, i}))
+				q.Values()
+			}
+		}(p)
+	}
+	wg.Wait()
+
+	assert.True(t, q.IsFull())
+	next := make([]int, producers)
+	for _, item := range q.All() {
+		assert.Equal(t, next[item[0]], item[1])
+		next[item[0]]++
+	}
+}
+
+func TestSynchronizedDequeueIf(t *testing.T) {
+	inner, _ := Of(3, 1, 2)
+	q := NewSynchronizedQueue[int](&inner)
+	_, ok := q.DequeueIf(func(item int) bool { return item == 2 })
+	assert.False(t, ok)
+	item, ok := q.DequeueIf(func(item int) bool { return item == 1 })
+	assert.True(t, ok)
+	assert.Equal(t, 1, item)
+
+	q.SetMax(1)
+	assert.True(t, q.IsFull())
+	q.Atomic(func(queue Queue[int]) {
+		front, _ := queue.Dequeue()
+		queue.Enqueue(front * 10)
+	})
+	front, err := q.Peek()
+	assert.Nil(t, err)
+	assert.Equal(t, 20, front)
+	assert.Equal(t, "[20]", q.String())
+
+	q.Dequeue()
+	_, ok = q.DequeueIfPresent()
+	assert.False(t, ok)
+	assert.Equal(t, 0, q.Size())
+}
@@ -0,0 +1,40 @@
+package stack
+
+import (
+	"bytes"
+	"io"
+	"slices"
+
+	"github.com/OmarFaruk-0x01/go_algorithms/datastructure/internal/gobstream"
+)
+
+// Encode writes the stack to w in a compact binary form: a gob header with the size followed by the items one by one from the top to the bottom. Gob can not encode nil pointers, so a stack holding one fails to encode.
+func (s stack[T]) Encode(w io.Writer) error {
+	return gobstream.Encode(w, gobstream.Header{Size: s.size}, s.Values())
+}
+
+// Decode replaces the items of the stack with the items Encode wrote to r. The stack is only changed if the whole stack could be read. Unless r implements io.ByteReader, Decode may read past the end of the encoded stack.
+func (s *stack[T]) Decode(r io.Reader) error {
+	items := []T{}
+	if _, err := gobstream.Decode(r, func(item T) { items = append(items, item) }); err != nil {
+		return err
+	}
+	// The items arrive from the top down, the storage keeps the top at the end.
+	slices.Reverse(items)
+	*s = stack[T]{stack: items, size: len(items)}
+	return nil
+}
+
+// MarshalBinary encodes the stack in the form Encode writes. It also makes the stack usable with encoding/gob.
+func (s stack[T]) MarshalBinary() ([]byte, error) {
+	var buffer bytes.Buffer
+	if err := s.Encode(&buffer); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// UnmarshalBinary replaces the items of the stack with the items MarshalBinary encoded.
+func (s *stack[T]) UnmarshalBinary(data []byte) error {
+	return s.Decode(bytes.NewReader(data))
+}
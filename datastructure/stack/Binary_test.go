@@ -0,0 +1,71 @@
+package stack
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	_ encoding.BinaryMarshaler   = stack[int]{}
+	_ encoding.BinaryUnmarshaler = (*stack[int])(nil)
+)
+
+func TestEncodeDecode(t *testing.T) {
+	original := Of(member{"Omar Faruk", 20}, member{"Tanvir Raj", 25})
+	var buffer bytes.Buffer
+	assert.Nil(t, original.Encode(&buffer))
+
+	decoded := Of(member{"old", 1})
+	assert.Nil(t, decoded.Decode(&buffer))
+	assert.True(t, decoded.EqualFunc(original, func(a, b member) bool { return a == b }))
+	top, _ := decoded.Pop()
+	assert.Equal(t, member{"Tanvir Raj", 25}, top)
+	assert.Equal(t, 2, original.Size())
+}
+
+func TestDecodeKeepsStackOnError(t *testing.T) {
+	data, err := Of(1, 2, 3).MarshalBinary()
+	assert.Nil(t, err)
+
+	s := Of(7)
+	assert.ErrorIs(t, s.UnmarshalBinary(data[:len(data)-2]), io.ErrUnexpectedEOF)
+	top, _ := s.Peek()
+	assert.Equal(t, 7, top)
+	assert.Equal(t, 1, s.Size())
+
+	assert.Nil(t, s.UnmarshalBinary(data))
+	assert.True(t, Equal[int](s, Of(1, 2, 3)))
+
+	empty, _ := New[int]().MarshalBinary()
+	assert.Nil(t, s.UnmarshalBinary(empty))
+	assert.True(t, s.IsEmpty())
+}
+
+func TestGob(t *testing.T) {
+	type history struct {
+		Undo *stack[member]
+		Redo *stack[member]
+	}
+	original := history{Undo: Of(member{"a", 1}, member{"b", 2}), Redo: New[member]()}
+
+	var buffer bytes.Buffer
+	assert.Nil(t, gob.NewEncoder(&buffer).Encode(original))
+	var decoded history
+	assert.Nil(t, gob.NewDecoder(&buffer).Decode(&decoded))
+	assert.True(t, Equal[member](decoded.Undo, original.Undo))
+	assert.True(t, decoded.Redo.IsEmpty())
+}
+
+func TestDecodedEmptyStackMarshalsJSONArray(t *testing.T) {
+	data, _ := New[int]().MarshalBinary()
+	s := Of(1)
+	assert.Nil(t, s.UnmarshalBinary(data))
+	encoded, err := s.MarshalJSON()
+	assert.Nil(t, err)
+	assert.Equal(t, "[]", string(encoded))
+}
@@ -0,0 +1,25 @@
+package stack_test
+
+import (
+	"testing"
+
+	"github.com/OmarFaruk-0x01/go_algorithms/datastructure/containertest"
+	"github.com/OmarFaruk-0x01/go_algorithms/datastructure/stack"
+)
+
+// TestConformance runs the shared stack suite against every Stack of the package. It lives in the external test package because containertest imports stack.
+func TestConformance(t *testing.T) {
+	implementations := []struct {
+		name     string
+		newStack func() stack.Stack[int]
+	}{
+		{"Stack", func() stack.Stack[int] { return stack.New[int]() }},
+		{"SynchronizedStack", func() stack.Stack[int] { return stack.NewSynchronized[int](stack.New[int]()) }},
+		{"LockFreeStack", func() stack.Stack[int] { return stack.NewLockFree[int]() }},
+	}
+	for _, implementation := range implementations {
+		t.Run(implementation.name, func(t *testing.T) {
+			containertest.RunStackSuite(t, implementation.newStack)
+		})
+	}
+}
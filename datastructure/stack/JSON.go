@@ -0,0 +1,24 @@
+package stack
+
+import (
+	"encoding/json"
+	"slices"
+)
+
+// MarshalJSON encodes the stack as a JSON array from the top to the bottom, the same order the stack is iterated in.
+func (s stack[T]) MarshalJSON() ([]byte, error) {
+	items := slices.Clone(s.stack)
+	slices.Reverse(items)
+	return json.Marshal(items)
+}
+
+// UnmarshalJSON replaces the items of the stack with the items of a JSON array whose first item is the top, so a marshalled stack decodes to the same stack. A JSON null empties the stack.
+func (s *stack[T]) UnmarshalJSON(data []byte) error {
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	slices.Reverse(items)
+	*s = *FromSlice(items)
+	return nil
+}
@@ -0,0 +1,59 @@
+package stack
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type member struct {
+	Name string `json:"name"`
+	Age  uint   `json:"age"`
+}
+
+func TestMarshalJSON(t *testing.T) {
+	s := New[member]()
+	s.Push(member{"Omar Faruk", 20})
+	s.Push(member{"Tanvir Raj", 25})
+
+	data, err := json.Marshal(s)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `[{"name":"Tanvir Raj","age":25},{"name":"Omar Faruk","age":20}]`, string(data))
+	assert.Equal(t, 2, s.Size())
+
+	empty, err := json.Marshal(New[int]())
+	assert.Nil(t, err)
+	assert.Equal(t, "[]", string(empty))
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	s := Of(1, 2, 3)
+	data, _ := json.Marshal(s)
+
+	decoded := Of(100)
+	assert.Nil(t, json.Unmarshal(data, decoded))
+	assert.True(t, Equal[int](s, decoded))
+	top, _ := decoded.Pop()
+	assert.Equal(t, 3, top)
+	assert.Equal(t, 3, s.Size())
+
+	assert.Nil(t, json.Unmarshal([]byte("null"), decoded))
+	assert.True(t, decoded.IsEmpty())
+	assert.NotNil(t, json.Unmarshal([]byte(`"text"`), decoded))
+}
+
+func TestJSONRoundTripInStruct(t *testing.T) {
+	type history struct {
+		Undo *stack[member] `json:"undo"`
+	}
+	original := history{Undo: Of(member{"a", 1}, member{"b", 2})}
+	data, err := json.Marshal(original)
+	assert.Nil(t, err)
+
+	var decoded history
+	assert.Nil(t, json.Unmarshal(data, &decoded))
+	top, _ := decoded.Undo.Peek()
+	assert.Equal(t, member{"b", 2}, top)
+	assert.True(t, decoded.Undo.EqualFunc(original.Undo, func(a, b member) bool { return a == b }))
+}
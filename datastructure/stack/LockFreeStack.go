@@ -0,0 +1,102 @@
+package stack
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"sync/atomic"
+)
+
+// lockFreeNode is a cell of the lock-free stack. A node is never changed once it is published on top of the stack, so readers can walk the nodes below any top they loaded.
+type lockFreeNode[T any] struct {
+	value T
+	next  *lockFreeNode[T]
+}
+
+// lockFreeStack is a Treiber stack: the top pointer is swapped with compare-and-swap, so many goroutines can push and pop without a lock and none of them can block the others.
+//
+// Fields:
+//
+//	top: The node on top of the stack, nil when the stack is empty.
+//	size: The number of items, it may lag behind the nodes while pushes and pops are in flight.
+type lockFreeStack[T any] struct {
+	top  atomic.Pointer[lockFreeNode[T]]
+	size atomic.Int64
+}
+
+// NewLockFree creates an empty lock-free stack that can be shared between goroutines.
+func NewLockFree[T any]() *lockFreeStack[T] {
+	return &lockFreeStack[T]{}
+}
+
+func (s *lockFreeStack[T]) Push(item T) {
+	newNode := &lockFreeNode[T]{value: item}
+	for {
+		top := s.top.Load()
+		newNode.next = top
+		if s.top.CompareAndSwap(top, newNode) {
+			s.size.Add(1)
+			return
+		}
+	}
+}
+
+func (s *lockFreeStack[T]) Pop() (T, error) {
+	for {
+		top := s.top.Load()
+		if top == nil {
+			var _nil T
+			return _nil, ErrEmpty
+		}
+		if s.top.CompareAndSwap(top, top.next) {
+			s.size.Add(-1)
+			return top.value, nil
+		}
+	}
+}
+
+func (s *lockFreeStack[T]) Peek() (T, error) {
+	top := s.top.Load()
+	if top == nil {
+		var _nil T
+		return _nil, ErrEmpty
+	}
+	return top.value, nil
+}
+
+// Size returns the number of items. While other goroutines push and pop it is only an approximation.
+func (s *lockFreeStack[T]) Size() int {
+	return max(int(s.size.Load()), 0)
+}
+
+func (s *lockFreeStack[T]) IsEmpty() bool {
+	return s.top.Load() == nil
+}
+
+// All returns an iterator over the items of the stack from the top to the bottom as they were when the iteration started. Pushes and pops that happen during the iteration are not seen.
+func (s *lockFreeStack[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		index := 0
+		for node := s.top.Load(); node != nil; node = node.next {
+			if !yield(index, node.value) {
+				return
+			}
+			index++
+		}
+	}
+}
+
+// Values returns an iterator over the items of the stack from the top to the bottom as they were when the iteration started.
+func (s *lockFreeStack[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for node := s.top.Load(); node != nil; node = node.next {
+			if !yield(node.value) {
+				return
+			}
+		}
+	}
+}
+
+func (s *lockFreeStack[T]) String() string {
+	return fmt.Sprintf("%v", slices.Collect(s.Values()))
+}
@@ -0,0 +1,110 @@
+package stack
+
+import (
+	"runtime"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLockFreePushPop(t *testing.T) {
+	s := NewLockFree[int]()
+	_, err := s.Pop()
+	assert.ErrorIs(t, err, ErrEmpty)
+	_, err = s.Peek()
+	assert.ErrorIs(t, err, ErrEmpty)
+	assert.True(t, s.IsEmpty())
+
+	s.Push(1)
+	s.Push(2)
+	s.Push(3)
+	assert.Equal(t, 3, s.Size())
+	assert.Equal(t, []int{3, 2, 1}, slices.Collect(s.Values()))
+	assert.Equal(t, "[3 2 1]", s.String())
+	assert.True(t, Equal[int](s, Of(1, 2, 3)))
+
+	top, err := s.Peek()
+	assert.Nil(t, err)
+	assert.Equal(t, 3, top)
+	top, err = s.Pop()
+	assert.Nil(t, err)
+	assert.Equal(t, 3, top)
+	assert.Equal(t, 2, s.Size())
+}
+
+func TestLockFreeStress(t *testing.T) {
+	const workers, items = 8, 2000
+	s := NewLockFree[int]()
+
+	var wg sync.WaitGroup
+	popped := make(chan []int, workers)
+	for w := 0; w < workers; w++ {
+		wg.Add(2)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < items; i++ {
+				s.Push(w*items + i)
+			}
+		}(w)
+		go func() {
+			defer wg.Done()
+			mine := []int{}
+			for len(mine) < items {
+				if item, err := s.Pop(); err == nil {
+					mine = append(mine, item)
+				} else {
+					runtime.Gosched()
+				}
+				for range s.Values() {
+					break
+				}
+			}
+			popped <- mine
+		}()
+	}
+	wg.Wait()
+	close(popped)
+
+	assert.True(t, s.IsEmpty())
+	assert.Equal(t, 0, s.Size())
+	all := []int{}
+	for mine := range popped {
+		all = append(all, mine...)
+	}
+	slices.Sort(all)
+	assert.Len(t, all, workers*items)
+	for i, item := range all {
+		assert.Equal(t, i, item)
+	}
+}
+
+func benchmarkConcurrentStacks(b *testing.B, run func(b *testing.B, s Stack[int])) {
+	implementations := []struct {
+		name     string
+		newStack func() Stack[int]
+	}{
+		{"LockFree", func() Stack[int] { return NewLockFree[int]() }},
+		{"Synchronized", func() Stack[int] { return NewSynchronized[int](New[int]()) }},
+	}
+	for _, implementation := range implementations {
+		b.Run(implementation.name, func(b *testing.B) {
+			run(b, implementation.newStack())
+		})
+	}
+}
+
+func BenchmarkConcurrentPushPop(b *testing.B) {
+	benchmarkConcurrentStacks(b, func(b *testing.B, s Stack[int]) {
+		b.ReportAllocs()
+		b.RunParallel(func(pb *testing.PB) {
+			i := 0
+			for pb.Next() {
+				s.Push(i)
+				s.Pop()
+				i++
+			}
+		})
+	})
+}
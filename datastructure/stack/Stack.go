@@ -1,32 +1,45 @@
 package stack
 
 import (
-	"errors"
 	"fmt"
-)
+	"iter"
 
-const (
-	stack_empty_error = "stack is empty"
+	"github.com/OmarFaruk-0x01/go_algorithms/datastructure/errs"
 )
 
-type Stack[T comparable] interface {
+// ErrEmpty is returned when an item is read or removed from an empty stack.
+var ErrEmpty = errs.ErrEmpty
+
+type Stack[T any] interface {
 	Push(item T)
 	Pop() (T, error)
 	Peek() (T, error)
 	Size() int
 	IsEmpty() bool
+	All() iter.Seq2[int, T]
+	Values() iter.Seq[T]
 }
 
-type stack[T comparable] struct {
+type stack[T any] struct {
 	stack []T
 	size  int
 	_nil  T
 }
 
-func New[T comparable]() *stack[T] {
+func New[T any]() *stack[T] {
 	return &stack[T]{stack: []T{}, size: 0}
 }
 
+// FromSlice creates a stack holding the items of the given slice, pushed in order, so the last item of the slice is on top. The slice itself is not retained.
+func FromSlice[T any](items []T) *stack[T] {
+	return &stack[T]{stack: append([]T{}, items...), size: len(items)}
+}
+
+// Of creates a stack holding the given items, pushed in order, so the last item is on top.
+func Of[T any](items ...T) *stack[T] {
+	return FromSlice(items)
+}
+
 func (s *stack[T]) Push(item T) {
 	s.stack = append(s.stack, item)
 	s.size++
@@ -34,7 +47,7 @@ func (s *stack[T]) Push(item T) {
 
 func (s *stack[T]) Pop() (T, error) {
 	if s.IsEmpty() {
-		return s._nil, errors.New(stack_empty_error)
+		return s._nil, ErrEmpty
 	}
 	top := s.stack[len(s.stack)-1]
 	s.stack = s.stack[:len(s.stack)-1]
@@ -44,7 +57,7 @@ func (s *stack[T]) Pop() (T, error) {
 
 func (s *stack[T]) Peek() (T, error) {
 	if s.IsEmpty() {
-		return s._nil, errors.New(stack_empty_error)
+		return s._nil, ErrEmpty
 	}
 	return s.stack[len(s.stack)-1], nil
 }
@@ -57,6 +70,73 @@ func (s stack[T]) Size() int {
 	return s.size
 }
 
+// Clone creates a copy of the stack that does not share its storage with the original.
+func (s stack[T]) Clone() *stack[T] {
+	return FromSlice(s.stack)
+}
+
+// EqualFunc reports whether the other stack holds the same items in the same order from the top to the bottom, comparing the items with the given function.
+func (s stack[T]) EqualFunc(other Stack[T], equal func(a, b T) bool) bool {
+	if s.size != other.Size() {
+		return false
+	}
+	i := len(s.stack) - 1
+	for item := range other.Values() {
+		if i < 0 || !equal(s.stack[i], item) {
+			return false
+		}
+		i--
+	}
+	return true
+}
+
+// All returns an iterator over the items of the stack from the top to the bottom, in the order Pop would return them. The index of the top item is 0.
+func (s stack[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := len(s.stack) - 1; i >= 0; i-- {
+			if !yield(len(s.stack)-1-i, s.stack[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the items of the stack from the top to the bottom.
+func (s stack[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := len(s.stack) - 1; i >= 0; i-- {
+			if !yield(s.stack[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Equal reports whether two stacks of comparable items hold the same items in the same order.
+func Equal[T comparable](s Stack[T], other Stack[T]) bool {
+	return EqualFunc(s, other, func(a, b T) bool { return a == b })
+}
+
+// EqualFunc reports whether two stacks hold the same items in the same order from the top to the bottom, comparing the items with the given function. A stack with an EqualFunc method compares with it, any other stack is walked alongside the other one.
+func EqualFunc[T any](s Stack[T], other Stack[T], equal func(a, b T) bool) bool {
+	if comparer, ok := s.(interface {
+		EqualFunc(other Stack[T], equal func(a, b T) bool) bool
+	}); ok {
+		return comparer.EqualFunc(other, equal)
+	}
+	if s.Size() != other.Size() {
+		return false
+	}
+	next, stop := iter.Pull(other.Values())
+	defer stop()
+	for item := range s.Values() {
+		if value, ok := next(); !ok || !equal(item, value) {
+			return false
+		}
+	}
+	return true
+}
+
 func (s stack[T]) String() string {
 	return fmt.Sprintf("%v", s.stack)
 }
@@ -1,8 +1,11 @@
 package stack
 
 import (
+	"bytes"
+	"fmt"
 	"testing"
 
+	"github.com/OmarFaruk-0x01/go_algorithms/datastructure/errs"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -72,3 +75,172 @@ func TestPop(t *testing.T) {
 	assert.Equal(t, 200, val)
 
 }
+
+func TestAll(t *testing.T) {
+	stack := New[int]()
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(3)
+
+	indexes := []int{}
+	items := []int{}
+	for index, item := range stack.All() {
+		indexes = append(indexes, index)
+		items = append(items, item)
+	}
+	assert.Equal(t, []int{0, 1, 2}, indexes)
+	assert.Equal(t, []int{3, 2, 1}, items)
+
+	for item := range stack.Values() {
+		assert.Equal(t, 3, item)
+		break
+	}
+	assert.Equal(t, 3, stack.Size())
+}
+
+func TestFromSlice(t *testing.T) {
+	items := []int{1, 2, 3}
+	stack := FromSlice(items)
+	items[2] = 100
+	assert.Equal(t, 3, stack.Size())
+
+	val, err := stack.Pop()
+	assert.Nil(t, err)
+	assert.Equal(t, 3, val)
+	stack.Push(4)
+	assert.Equal(t, []int{1, 2, 100}, items)
+
+	letters := Of("a", "b")
+	val2, _ := letters.Peek()
+	assert.Equal(t, "b", val2)
+	assert.True(t, Of[int]().IsEmpty())
+}
+
+func TestCloneEqual(t *testing.T) {
+	stack := Of(1, 2, 3)
+	clone := stack.Clone()
+	assert.True(t, Equal[int](stack, clone))
+
+	clone.Pop()
+	assert.False(t, Equal[int](stack, clone))
+	assert.Equal(t, 3, stack.Size())
+	clone.Push(4)
+	assert.False(t, Equal[int](stack, clone))
+	clone.Pop()
+	clone.Push(3)
+	assert.True(t, Equal[int](clone, stack))
+
+	assert.True(t, Equal[int](New[int](), Of[int]()))
+}
+
+func TestEqualFunc(t *testing.T) {
+	sameLength := func(a, b string) bool { return len(a) == len(b) }
+	stack := Of("Omar", "Faruk")
+	lockFree := NewLockFree[string]()
+	lockFree.Push("Sadi")
+	lockFree.Push("Ahmad")
+	// The lock-free stack has no EqualFunc method, so it is walked alongside the other stack.
+	assert.True(t, EqualFunc[string](lockFree, stack, sameLength))
+	assert.True(t, EqualFunc[string](stack, lockFree, sameLength))
+	assert.False(t, Equal[string](lockFree, stack))
+
+	lockFree.Pop()
+	assert.False(t, EqualFunc[string](lockFree, stack, sameLength))
+}
+
+func TestErrors(t *testing.T) {
+	stack := New[int]()
+	_, err := stack.Pop()
+	assert.ErrorIs(t, err, ErrEmpty)
+	_, err = stack.Peek()
+	assert.ErrorIs(t, err, errs.ErrEmpty)
+}
+
+func TestAnyItemType(t *testing.T) {
+	stack := New[[]byte]()
+	stack.Push([]byte("Omar"))
+	stack.Push([]byte("Faruk"))
+	other := Of([]byte("Omar"), []byte("Faruk"))
+	assert.True(t, stack.EqualFunc(other, bytes.Equal))
+
+	top, err := stack.Pop()
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("Faruk"), top)
+	assert.False(t, stack.EqualFunc(other, bytes.Equal))
+}
+
+// benchmarkSizes are the stack sizes every method is measured at, so the growth of the timings shows the complexity of the method.
+var benchmarkSizes = []int{10, 100, 1000, 10000}
+
+// BenchmarkStack measures every method of the stack at every benchmark size. Push and Pop are paired with the opposite operation, so the stack keeps its size across iterations.
+func BenchmarkStack(b *testing.B) {
+	methods := []struct {
+		name string
+		run  func(b *testing.B, s *stack[int])
+	}{
+		{"Push", func(b *testing.B, s *stack[int]) {
+			for i := 0; i < b.N; i++ {
+				s.Push(i)
+				s.Pop()
+			}
+		}},
+		{"Pop", func(b *testing.B, s *stack[int]) {
+			for i := 0; i < b.N; i++ {
+				s.Pop()
+				s.Push(i)
+			}
+		}},
+		{"Peek", func(b *testing.B, s *stack[int]) {
+			for i := 0; i < b.N; i++ {
+				s.Peek()
+			}
+		}},
+		{"Size", func(b *testing.B, s *stack[int]) {
+			for i := 0; i < b.N; i++ {
+				s.Size()
+			}
+		}},
+		{"IsEmpty", func(b *testing.B, s *stack[int]) {
+			for i := 0; i < b.N; i++ {
+				s.IsEmpty()
+			}
+		}},
+		{"All", func(b *testing.B, s *stack[int]) {
+			for i := 0; i < b.N; i++ {
+				for range s.All() {
+				}
+			}
+		}},
+		{"Values", func(b *testing.B, s *stack[int]) {
+			for i := 0; i < b.N; i++ {
+				for range s.Values() {
+				}
+			}
+		}},
+		{"Clone", func(b *testing.B, s *stack[int]) {
+			for i := 0; i < b.N; i++ {
+				s.Clone()
+			}
+		}},
+		{"EqualFunc", func(b *testing.B, s *stack[int]) {
+			other := s.Clone()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				s.EqualFunc(other, func(a, b int) bool { return a == b })
+			}
+		}},
+	}
+	for _, method := range methods {
+		for _, size := range benchmarkSizes {
+			b.Run(fmt.Sprintf("%s/size=%d", method.name, size), func(b *testing.B) {
+				s := New[int]()
+				for i := 0; i < size; i++ {
+					s.Push(i)
+				}
+				b.ReportAllocs()
+				b.ResetTimer()
+				method.run(b, s)
+			})
+		}
+	}
+}
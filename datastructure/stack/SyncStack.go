@@ -0,0 +1,103 @@
+package stack
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"sync"
+)
+
+// syncStack guards another Stack with a mutex so it can be shared between goroutines. Every method holds the lock for its whole duration, which also makes the compound operations like PopIfPresent atomic.
+//
+// Fields:
+//
+//	mu: The mutex guarding the wrapped stack.
+//	stack: The wrapped stack, it must not be used directly once wrapped.
+type syncStack[T any] struct {
+	mu    sync.Mutex
+	stack Stack[T]
+}
+
+// NewSynchronized wraps the given stack so it can be used from many goroutines at once.
+func NewSynchronized[T any](s Stack[T]) *syncStack[T] {
+	return &syncStack[T]{stack: s}
+}
+
+func (s *syncStack[T]) Push(item T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stack.Push(item)
+}
+
+func (s *syncStack[T]) Pop() (T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stack.Pop()
+}
+
+func (s *syncStack[T]) Peek() (T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stack.Peek()
+}
+
+func (s *syncStack[T]) Size() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stack.Size()
+}
+
+func (s *syncStack[T]) IsEmpty() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stack.IsEmpty()
+}
+
+// PopIfPresent pops the top item if there is one. Unlike checking IsEmpty before calling Pop, no other goroutine can empty the stack in between.
+func (s *syncStack[T]) PopIfPresent() (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	item, err := s.stack.Pop()
+	return item, err == nil
+}
+
+// PopIf pops the top item only if the predicate returns true for it.
+func (s *syncStack[T]) PopIf(predicate func(item T) bool) (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	item, err := s.stack.Peek()
+	if err != nil || !predicate(item) {
+		var _nil T
+		return _nil, false
+	}
+	s.stack.Pop()
+	return item, true
+}
+
+// Atomic runs the given function with exclusive access to the wrapped stack, so several operations happen without any other goroutine seeing the stack in between. The stack must not be used after the function returns.
+func (s *syncStack[T]) Atomic(operation func(stack Stack[T])) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	operation(s.stack)
+}
+
+// All returns an iterator over a snapshot of the items of the stack from the top to the bottom. The snapshot is taken under the lock, so the loop body can use the stack freely.
+func (s *syncStack[T]) All() iter.Seq2[int, T] {
+	return slices.All(s.snapshot())
+}
+
+// Values returns an iterator over a snapshot of the items of the stack from the top to the bottom.
+func (s *syncStack[T]) Values() iter.Seq[T] {
+	return slices.Values(s.snapshot())
+}
+
+func (s *syncStack[T]) String() string {
+	return fmt.Sprintf("%v", s.snapshot())
+}
+
+// snapshot copies the items of the wrapped stack from the top to the bottom.
+func (s *syncStack[T]) snapshot() []T {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Collect(s.stack.Values())
+}
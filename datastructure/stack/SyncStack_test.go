@@ -0,0 +1,100 @@
+package stack
+
+import (
+	"runtime"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSynchronizedPushPop(t *testing.T) {
+	const producers, items = 8, 1000
+	s := NewSynchronized[int](New[int]())
+
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			for i := 0; i < items; i++ {
+				s.Push(p*items + i)
+			}
+		}(p)
+	}
+
+	popped := make(chan int, producers*items)
+	for c := 0; c < producers; c++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < items/2; {
+				if item, ok := s.PopIfPresent(); ok {
+					popped <- item
+					i++
+				} else {
+					runtime.Gosched()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(popped)
+
+	assert.Equal(t, producers*items/2, s.Size())
+	seen := slices.Collect(s.Values())
+	for item := range popped {
+		seen = append(seen, item)
+	}
+	slices.Sort(seen)
+	for i, item := range seen {
+		assert.Equal(t, i, item)
+	}
+}
+
+func TestSynchronizedPopIf(t *testing.T) {
+	s := NewSynchronized[int](Of(1, 2))
+	_, ok := s.PopIf(func(item int) bool { return item == 1 })
+	assert.False(t, ok)
+	item, ok := s.PopIf(func(item int) bool { return item == 2 })
+	assert.True(t, ok)
+	assert.Equal(t, 2, item)
+
+	s.Pop()
+	_, ok = s.PopIfPresent()
+	assert.False(t, ok)
+	_, ok = s.PopIf(func(item int) bool { return true })
+	assert.False(t, ok)
+	_, err := s.Peek()
+	assert.ErrorIs(t, err, ErrEmpty)
+}
+
+func TestSynchronizedAtomic(t *testing.T) {
+	const workers, rounds = 8, 500
+	s := NewSynchronized[int](Of(0))
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < rounds; i++ {
+				s.Atomic(func(stack Stack[int]) {
+					top, _ := stack.Pop()
+					stack.Push(top + 1)
+				})
+				for range s.Values() {
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	top, err := s.Peek()
+	assert.Nil(t, err)
+	assert.Equal(t, workers*rounds, top)
+	assert.Equal(t, 1, s.Size())
+	assert.False(t, s.IsEmpty())
+	assert.Equal(t, "[4000]", s.String())
+}